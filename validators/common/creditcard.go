@@ -7,6 +7,20 @@ import (
 	"strings"
 )
 
+// cardRules 卡组织规则
+var cardRules = []struct {
+	pattern *regexp.Regexp
+	name    string
+}{
+	{pattern: regexp.MustCompile(`^4[0-9]{12}(?:[0-9]{3})?$`), name: "Visa"},
+	{pattern: regexp.MustCompile(`^5[1-5][0-9]{14}$`), name: "MasterCard"},
+	{pattern: regexp.MustCompile(`^3[47][0-9]{13}$`), name: "American Express"},
+	{pattern: regexp.MustCompile(`^6(?:011|5[0-9]{2})[0-9]{12}$`), name: "Discover"},
+}
+
+// expiryDatePattern 有效期格式（MM/YY）
+var expiryDatePattern = regexp.MustCompile(`^(0[1-9]|1[0-2])/([0-9]{2})$`)
+
 // CreditCardValidator 信用卡号验证器
 type CreditCardValidator struct {
 	FieldName string // 字段名称
@@ -49,36 +63,9 @@ func (v *CreditCardValidator) ValidateCardType() func(string) error {
 		s = strings.ReplaceAll(s, " ", "")
 		s = strings.ReplaceAll(s, "-", "")
 
-		// 定义卡组织规则
-		cardRules := map[string]struct {
-			pattern string
-			name    string
-		}{
-			"visa": {
-				pattern: "^4[0-9]{12}(?:[0-9]{3})?$",
-				name:    "Visa",
-			},
-			"mastercard": {
-				pattern: "^5[1-5][0-9]{14}$",
-				name:    "MasterCard",
-			},
-			"amex": {
-				pattern: "^3[47][0-9]{13}$",
-				name:    "American Express",
-			},
-			"discover": {
-				pattern: "^6(?:011|5[0-9]{2})[0-9]{12}$",
-				name:    "Discover",
-			},
-		}
-
 		// 验证卡组织
 		for _, rule := range cardRules {
-			matched, err := regexp.MatchString(rule.pattern, s)
-			if err != nil {
-				return fmt.Errorf("验证失败: %v", err)
-			}
-			if matched {
+			if rule.pattern.MatchString(s) {
 				return nil
 			}
 		}
@@ -125,12 +112,7 @@ func (v *CreditCardValidator) ValidateLuhn() func(string) error {
 func (v *CreditCardValidator) ValidateExpiryDate() func(string) error {
 	return func(s string) error {
 		// 验证格式（MM/YY）
-		pattern := `^(0[1-9]|1[0-2])/([0-9]{2})$`
-		matched, err := regexp.MatchString(pattern, s)
-		if err != nil {
-			return fmt.Errorf("验证失败: %v", err)
-		}
-		if !matched {
+		if !expiryDatePattern.MatchString(s) {
 			return fmt.Errorf("无效的有效期格式")
 		}
 
@@ -6,6 +6,9 @@ import (
 	"strings"
 )
 
+// emailPattern 邮箱基本格式
+var emailPattern = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
 // EmailValidator 邮箱验证器
 type EmailValidator struct {
 	FieldName string // 字段名称
@@ -25,12 +28,7 @@ func (v *EmailValidator) Validate() func(string) error {
 		s = strings.TrimSpace(s)
 
 		// 验证基本格式
-		pattern := `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
-		matched, err := regexp.MatchString(pattern, s)
-		if err != nil {
-			return fmt.Errorf("验证失败: %v", err)
-		}
-		if !matched {
+		if !emailPattern.MatchString(s) {
 			return fmt.Errorf("无效的邮箱格式")
 		}
 
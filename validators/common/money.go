@@ -0,0 +1,176 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/lyonnee/hvalid/validators/format"
+	"github.com/lyonnee/hvalid/validators/primitive"
+)
+
+// Money 金额，Amount 为十进制数字符串以避免浮点误差，例如 {"12.34", "USD"}
+type Money struct {
+	Amount   string // 金额
+	Currency string // ISO 4217 货币代码，大写
+}
+
+// MoneyLimit 单一货币的金额限额，空字符串表示不限制
+type MoneyLimit struct {
+	Min string // 最小金额（含）
+	Max string // 最大金额（含）
+}
+
+// MoneySign 金额符号规则
+type MoneySign int
+
+const (
+	// MoneyPositive 金额必须大于 0，用于扣款
+	MoneyPositive MoneySign = iota
+	// MoneyNegative 金额必须小于 0，用于以负数表示的退款
+	MoneyNegative
+	// MoneyNonNegative 金额不能小于 0
+	MoneyNonNegative
+	// MoneyNonZero 金额不能为 0
+	MoneyNonZero
+)
+
+// MoneyValidator 金额验证器，货币的辅币位数来自内置的 ISO 4217 表
+type MoneyValidator struct {
+	FieldName string // 字段名称
+}
+
+// NewMoneyValidator 创建一个新的金额验证器
+func NewMoneyValidator(fieldName string) *MoneyValidator {
+	return &MoneyValidator{
+		FieldName: fieldName,
+	}
+}
+
+// Validate 验证货币代码和金额格式，且小数位数不超过货币的辅币位数，例如 JPY 不允许小数、KWD 最多 3 位
+func (v *MoneyValidator) Validate() func(Money) error {
+	return func(m Money) error {
+		_, err := parseMoney(m)
+		return err
+	}
+}
+
+// ValidateLimits 验证金额在对应货币的限额内，limits 中没有配置的货币不允许使用；限额格式无效时 panic
+func (v *MoneyValidator) ValidateLimits(limits map[string]MoneyLimit) func(Money) error {
+	type bounds struct{ min, max *big.Rat }
+
+	parsed := make(map[string]bounds, len(limits))
+	for currency, limit := range limits {
+		var b bounds
+		if limit.Min != "" {
+			b.min = mustParseAmount(currency, limit.Min)
+		}
+		if limit.Max != "" {
+			b.max = mustParseAmount(currency, limit.Max)
+		}
+		parsed[currency] = b
+	}
+
+	return func(m Money) error {
+		amount, err := parseMoney(m)
+		if err != nil {
+			return err
+		}
+
+		b, ok := parsed[m.Currency]
+		if !ok {
+			return fmt.Errorf("不支持的货币: %s", m.Currency)
+		}
+		if b.min != nil && amount.Cmp(b.min) < 0 {
+			return fmt.Errorf("金额不能小于 %s %s", limits[m.Currency].Min, m.Currency)
+		}
+		if b.max != nil && amount.Cmp(b.max) > 0 {
+			return fmt.Errorf("金额不能大于 %s %s", limits[m.Currency].Max, m.Currency)
+		}
+		return nil
+	}
+}
+
+// ValidateSign 验证金额符号
+func (v *MoneyValidator) ValidateSign(sign MoneySign) func(Money) error {
+	return func(m Money) error {
+		amount, err := parseMoney(m)
+		if err != nil {
+			return err
+		}
+
+		switch s := amount.Sign(); {
+		case sign == MoneyPositive && s <= 0:
+			return fmt.Errorf("金额必须大于0")
+		case sign == MoneyNegative && s >= 0:
+			return fmt.Errorf("金额必须小于0")
+		case sign == MoneyNonNegative && s < 0:
+			return fmt.Errorf("金额不能为负数")
+		case sign == MoneyNonZero && s == 0:
+			return fmt.Errorf("金额不能为0")
+		}
+		return nil
+	}
+}
+
+// ValidateRefund 验证退款：货币与原交易一致、金额不为 0 且绝对值不超过原交易金额；
+// negative 为 true 时退款必须以负数表示，否则必须为正数；原交易金额无效时 panic
+func (v *MoneyValidator) ValidateRefund(original Money, negative bool) func(Money) error {
+	originalAmount, err := parseMoney(original)
+	if err != nil {
+		panic(fmt.Sprintf("common: invalid original money: %v", err))
+	}
+	originalAmount.Abs(originalAmount)
+
+	sign := MoneyPositive
+	if negative {
+		sign = MoneyNegative
+	}
+	checkSign := v.ValidateSign(sign)
+
+	return func(m Money) error {
+		if m.Currency != original.Currency {
+			return fmt.Errorf("退款货币必须与原交易一致: %s", original.Currency)
+		}
+		if err := checkSign(m); err != nil {
+			return fmt.Errorf("退款%v", err)
+		}
+
+		amount, _ := parseMoney(m)
+		if amount.Abs(amount).Cmp(originalAmount) > 0 {
+			return fmt.Errorf("退款金额不能超过原交易金额 %s %s", original.Amount, original.Currency)
+		}
+		return nil
+	}
+}
+
+// parseMoney 验证货币代码和小数位数并解析金额
+func parseMoney(m Money) (*big.Rat, error) {
+	units, ok := format.CurrencyMinorUnits(m.Currency)
+	if !ok {
+		return nil, fmt.Errorf("无效的货币代码: %s", m.Currency)
+	}
+	if units == format.NoMinorUnit {
+		return nil, fmt.Errorf("货币 %s 不能用于金额", m.Currency)
+	}
+
+	amount, err := primitive.ParseDecimal(m.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("无效的金额格式: %s", m.Amount)
+	}
+	if _, ok := primitive.DecimalScaleWithin(amount, units); !ok {
+		if units == 0 {
+			return nil, fmt.Errorf("%s 金额不能有小数", m.Currency)
+		}
+		return nil, fmt.Errorf("%s 金额最多%d位小数", m.Currency, units)
+	}
+	return amount, nil
+}
+
+// mustParseAmount 解析限额，失败时 panic
+func mustParseAmount(currency, amount string) *big.Rat {
+	r, err := parseMoney(Money{Amount: amount, Currency: currency})
+	if err != nil {
+		panic(fmt.Sprintf("common: invalid limit: %v", err))
+	}
+	return r
+}
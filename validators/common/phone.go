@@ -6,6 +6,9 @@ import (
 	"strings"
 )
 
+// internationalPhonePattern 国际手机号格式
+var internationalPhonePattern = regexp.MustCompile(`^\+[1-9]\d{0,3}-[1-9]\d{0,3}-\d{3,4}-\d{4}$`)
+
 // PhoneValidator 手机号验证器
 type PhoneValidator struct {
 	FieldName string // 字段名称
@@ -55,12 +58,7 @@ func (v *PhoneValidator) ValidateCN() func(string) error {
 func (v *PhoneValidator) ValidateInternational() func(string) error {
 	return func(s string) error {
 		// 验证国际格式
-		pattern := `^\+[1-9]\d{0,3}-[1-9]\d{0,3}-\d{3,4}-\d{4}$`
-		matched, err := regexp.MatchString(pattern, s)
-		if err != nil {
-			return fmt.Errorf("验证失败: %v", err)
-		}
-		if !matched {
+		if !internationalPhonePattern.MatchString(s) {
 			return fmt.Errorf("无效的国际手机号格式")
 		}
 		return nil
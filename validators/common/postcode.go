@@ -8,6 +8,15 @@ import (
 	"github.com/lyonnee/hvalid/validators/primitive"
 )
 
+// 内置邮政编码格式
+var (
+	usPostcodePattern = regexp.MustCompile(`^\d{5}(-\d{4})?$`)                   // 美国邮政编码
+	ukPostcodePattern = regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`) // 英国邮政编码
+	caPostcodePattern = regexp.MustCompile(`^[A-Z]\d[A-Z]\d[A-Z]\d$`)            // 加拿大邮政编码
+	auPostcodePattern = regexp.MustCompile(`^\d{4}$`)                            // 澳大利亚邮政编码
+	jpPostcodePattern = regexp.MustCompile(`^\d{7}$`)                            // 日本邮政编码
+)
+
 // PostcodeValidator 邮政编码验证器
 type PostcodeValidator struct {
 	*primitive.StringValidator
@@ -54,12 +63,7 @@ func (v *PostcodeValidator) ValidateUS() func(string) error {
 		s = strings.ReplaceAll(s, " ", "")
 
 		// 验证格式（5位数字或5位数字-4位数字）
-		pattern := `^\d{5}(-\d{4})?$`
-		matched, err := regexp.MatchString(pattern, s)
-		if err != nil {
-			return fmt.Errorf("验证失败: %v", err)
-		}
-		if !matched {
+		if !usPostcodePattern.MatchString(s) {
 			return fmt.Errorf("美国邮政编码格式无效，应为5位数字或5位数字-4位数字")
 		}
 
@@ -74,12 +78,7 @@ func (v *PostcodeValidator) ValidateUK() func(string) error {
 		s = strings.ReplaceAll(s, " ", "")
 
 		// 验证格式（1-2个字母+1-2个数字+1个数字+2个字母）
-		pattern := `^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`
-		matched, err := regexp.MatchString(pattern, strings.ToUpper(s))
-		if err != nil {
-			return fmt.Errorf("验证失败: %v", err)
-		}
-		if !matched {
+		if !ukPostcodePattern.MatchString(strings.ToUpper(s)) {
 			return fmt.Errorf("英国邮政编码格式无效")
 		}
 
@@ -94,12 +93,7 @@ func (v *PostcodeValidator) ValidateCA() func(string) error {
 		s = strings.ReplaceAll(s, " ", "")
 
 		// 验证格式（字母数字字母 数字字母数字）
-		pattern := `^[A-Z]\d[A-Z]\d[A-Z]\d$`
-		matched, err := regexp.MatchString(pattern, strings.ToUpper(s))
-		if err != nil {
-			return fmt.Errorf("验证失败: %v", err)
-		}
-		if !matched {
+		if !caPostcodePattern.MatchString(strings.ToUpper(s)) {
 			return fmt.Errorf("加拿大邮政编码格式无效，应为字母数字字母数字字母数字")
 		}
 
@@ -114,12 +108,7 @@ func (v *PostcodeValidator) ValidateAU() func(string) error {
 		s = strings.ReplaceAll(s, " ", "")
 
 		// 验证格式（4位数字）
-		pattern := `^\d{4}$`
-		matched, err := regexp.MatchString(pattern, s)
-		if err != nil {
-			return fmt.Errorf("验证失败: %v", err)
-		}
-		if !matched {
+		if !auPostcodePattern.MatchString(s) {
 			return fmt.Errorf("澳大利亚邮政编码必须是4位数字")
 		}
 
@@ -135,12 +124,7 @@ func (v *PostcodeValidator) ValidateJP() func(string) error {
 		s = strings.ReplaceAll(s, "-", "")
 
 		// 验证格式（7位数字）
-		pattern := `^\d{7}$`
-		matched, err := regexp.MatchString(pattern, s)
-		if err != nil {
-			return fmt.Errorf("验证失败: %v", err)
-		}
-		if !matched {
+		if !jpPostcodePattern.MatchString(s) {
 			return fmt.Errorf("日本邮政编码必须是7位数字")
 		}
 
@@ -148,18 +132,19 @@ func (v *PostcodeValidator) ValidateJP() func(string) error {
 	}
 }
 
-// ValidateFormat 验证自定义格式的邮政编码
+// ValidateFormat 验证自定义格式的邮政编码，表达式通过共享缓存编译，无效时在创建验证函数时 panic
 func (v *PostcodeValidator) ValidateFormat(pattern string) func(string) error {
+	return v.ValidateFormatRegexp(primitive.MustCompileRegexp(pattern))
+}
+
+// ValidateFormatRegexp 使用已编译的正则表达式验证自定义格式的邮政编码
+func (v *PostcodeValidator) ValidateFormatRegexp(re *regexp.Regexp) func(string) error {
 	return func(s string) error {
 		// 移除所有空格
 		s = strings.ReplaceAll(s, " ", "")
 
 		// 验证格式
-		matched, err := regexp.MatchString(pattern, s)
-		if err != nil {
-			return fmt.Errorf("验证失败: %v", err)
-		}
-		if !matched {
+		if !re.MatchString(s) {
 			return fmt.Errorf("邮政编码格式无效")
 		}
 
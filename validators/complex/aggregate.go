@@ -48,6 +48,11 @@ type WeightedValidator[T any] struct {
 }
 
 func (v *AggregateValidator[T]) AggregateWithWeight(weightedValidators []WeightedValidator[T]) hvalid.ValidatorFunc[T] {
+	return v.AggregateWithWeightThreshold(weightedValidators, 0.5)
+}
+
+// AggregateWithWeightThreshold 使用权重聚合多个验证器的结果，通过的权重占比不低于 threshold 时验证通过
+func (v *AggregateValidator[T]) AggregateWithWeightThreshold(weightedValidators []WeightedValidator[T], threshold float64) hvalid.ValidatorFunc[T] {
 	return hvalid.ValidatorFunc[T](func(value T) error {
 		validationErr := hvalid.NewValidationError(v.FieldName)
 		totalWeight := 0.0
@@ -62,7 +67,7 @@ func (v *AggregateValidator[T]) AggregateWithWeight(weightedValidators []Weighte
 			totalWeight += wv.Weight
 		}
 
-		if totalWeight == 0 || successWeight/totalWeight < 0.5 {
+		if totalWeight == 0 || successWeight/totalWeight < threshold {
 			return validationErr
 		}
 		return nil
@@ -0,0 +1,273 @@
+package complex
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// ErrCircuitOpen 熔断器打开时拒绝执行验证返回的错误
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerState 熔断器状态
+type BreakerState int
+
+const (
+	// StateClosed 关闭状态，正常执行验证
+	StateClosed BreakerState = iota
+	// StateOpen 打开状态，拒绝执行验证
+	StateOpen
+	// StateHalfOpen 半开状态，放行少量探测请求
+	StateHalfOpen
+)
+
+// String 返回状态名称
+func (s BreakerState) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// FallbackMode 熔断器打开时的降级行为
+type FallbackMode int
+
+const (
+	// FailClosed 返回 ErrCircuitOpen
+	FailClosed FallbackMode = iota
+	// FailOpen 视为验证通过
+	FailOpen
+	// Degrade 改用 Fallback 指定的本地验证器
+	Degrade
+)
+
+// CircuitBreakerConfig 熔断器配置
+type CircuitBreakerConfig[T any] struct {
+	WindowSize           int                         // 统计失败率的最近调用数，默认 10
+	MinRequests          int                         // 窗口内至少有多少次调用才计算失败率，默认等于 WindowSize
+	FailureRateThreshold float64                     // 失败率达到该值时打开，取值 (0, 1]，默认 0.5
+	Cooldown             time.Duration               // 打开后经过多久进入半开状态，默认 30s
+	HalfOpenProbes       int                         // 半开状态放行的探测请求数，全部成功后关闭，默认 1
+	IsFailure            func(error) bool            // 判断错误是否计为后端失败，为空时使用 IsBackendFailure，*hvalid.ValidationError 不计为失败
+	FallbackMode         FallbackMode                // 打开时的降级行为
+	Fallback             hvalid.ValidatorFunc[T]     // Degrade 模式使用的验证器
+	OnStateChange        func(from, to BreakerState) // 状态变化回调，在锁外调用
+	Clock                Clock                       // 时钟，为空时使用系统时钟
+}
+
+// CircuitBreakerValidator 熔断验证器结构体
+type CircuitBreakerValidator[T any] struct {
+	FieldName string // 字段名称
+	config    CircuitBreakerConfig[T]
+	clock     Clock
+
+	mu         sync.Mutex
+	state      BreakerState
+	generation uint64    // 每次状态变化递增，用于丢弃旧状态下发起的调用结果
+	window     []bool    // 最近调用是否失败的环形缓冲区
+	next       int       // 下一个写入位置
+	count      int       // 窗口内的调用数
+	failures   int       // 窗口内的失败数
+	openedAt   time.Time // 最近一次打开的时间
+	probes     int       // 半开状态已放行的探测请求数
+	successes  int       // 半开状态成功的探测请求数
+}
+
+// NewCircuitBreakerValidator 创建熔断验证器
+func NewCircuitBreakerValidator[T any](fieldName string, config CircuitBreakerConfig[T]) *CircuitBreakerValidator[T] {
+	if config.WindowSize <= 0 {
+		config.WindowSize = 10
+	}
+	if config.MinRequests <= 0 || config.MinRequests > config.WindowSize {
+		config.MinRequests = config.WindowSize
+	}
+	if config.FailureRateThreshold <= 0 || config.FailureRateThreshold > 1 {
+		config.FailureRateThreshold = 0.5
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = 30 * time.Second
+	}
+	if config.HalfOpenProbes <= 0 {
+		config.HalfOpenProbes = 1
+	}
+
+	return &CircuitBreakerValidator[T]{
+		FieldName: fieldName,
+		config:    config,
+		clock:     clockOrDefault(config.Clock),
+		window:    make([]bool, config.WindowSize),
+	}
+}
+
+// WithBreaker 使用熔断器执行验证
+func (v *CircuitBreakerValidator[T]) WithBreaker(validator hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		generation, allowed := v.allow()
+		if !allowed {
+			return v.fallback(value)
+		}
+
+		failed := true
+		defer func() {
+			// 验证器 panic 时同样计为失败，避免半开状态的探测名额泄漏
+			v.record(generation, failed)
+		}()
+
+		err := validator(value)
+		failed = err != nil && v.isFailure(err)
+		return err
+	})
+}
+
+// State 获取当前状态
+func (v *CircuitBreakerValidator[T]) State() BreakerState {
+	v.mu.Lock()
+	from, to := v.state, v.refreshLocked()
+	v.mu.Unlock()
+
+	v.notify(from, to)
+	return to
+}
+
+// Reset 重置为关闭状态并清空统计
+func (v *CircuitBreakerValidator[T]) Reset() {
+	v.mu.Lock()
+	from := v.state
+	v.transitionLocked(StateClosed)
+	v.mu.Unlock()
+
+	v.notify(from, StateClosed)
+}
+
+// allow 判断是否放行本次调用
+func (v *CircuitBreakerValidator[T]) allow() (uint64, bool) {
+	v.mu.Lock()
+	from, to := v.state, v.refreshLocked()
+
+	allowed := true
+	switch to {
+	case StateOpen:
+		allowed = false
+	case StateHalfOpen:
+		if v.probes >= v.config.HalfOpenProbes {
+			allowed = false
+		} else {
+			v.probes++
+		}
+	}
+	generation := v.generation
+	v.mu.Unlock()
+
+	v.notify(from, to)
+	return generation, allowed
+}
+
+// record 记录调用结果
+func (v *CircuitBreakerValidator[T]) record(generation uint64, failed bool) {
+	v.mu.Lock()
+	if generation != v.generation {
+		// 状态已变化，丢弃旧结果
+		v.mu.Unlock()
+		return
+	}
+
+	from := v.state
+	switch v.state {
+	case StateClosed:
+		if v.count == len(v.window) {
+			if v.window[v.next] {
+				v.failures--
+			}
+		} else {
+			v.count++
+		}
+		v.window[v.next] = failed
+		v.next = (v.next + 1) % len(v.window)
+		if failed {
+			v.failures++
+		}
+
+		if v.count >= v.config.MinRequests && float64(v.failures)/float64(v.count) >= v.config.FailureRateThreshold {
+			v.transitionLocked(StateOpen)
+		}
+	case StateHalfOpen:
+		if failed {
+			v.transitionLocked(StateOpen)
+		} else if v.successes++; v.successes >= v.config.HalfOpenProbes {
+			v.transitionLocked(StateClosed)
+		}
+	}
+	to := v.state
+	v.mu.Unlock()
+
+	v.notify(from, to)
+}
+
+// refreshLocked 冷却时间结束后从打开状态进入半开状态，返回当前状态
+func (v *CircuitBreakerValidator[T]) refreshLocked() BreakerState {
+	if v.state == StateOpen && !v.clock.Now().Before(v.openedAt.Add(v.config.Cooldown)) {
+		v.transitionLocked(StateHalfOpen)
+	}
+	return v.state
+}
+
+// transitionLocked 切换状态并重置相应的统计
+func (v *CircuitBreakerValidator[T]) transitionLocked(to BreakerState) {
+	v.state = to
+	v.generation++
+	v.probes = 0
+	v.successes = 0
+
+	switch to {
+	case StateOpen:
+		v.openedAt = v.clock.Now()
+	case StateClosed:
+		for i := range v.window {
+			v.window[i] = false
+		}
+		v.next, v.count, v.failures = 0, 0, 0
+	}
+}
+
+// notify 状态变化时调用回调
+func (v *CircuitBreakerValidator[T]) notify(from, to BreakerState) {
+	if from != to && v.config.OnStateChange != nil {
+		v.config.OnStateChange(from, to)
+	}
+}
+
+// isFailure 判断错误是否计为失败
+func (v *CircuitBreakerValidator[T]) isFailure(err error) bool {
+	if v.config.IsFailure == nil {
+		return IsBackendFailure(err)
+	}
+	return v.config.IsFailure(err)
+}
+
+// IsBackendFailure 判断错误是否计为后端失败：除 *hvalid.ValidationError 以外的错误都计为失败；
+// 验证未通过不计为失败，避免大量无效输入打开熔断器
+func IsBackendFailure(err error) bool {
+	var validationErr *hvalid.ValidationError
+	return err != nil && !errors.As(err, &validationErr)
+}
+
+// fallback 熔断器打开时执行降级行为
+func (v *CircuitBreakerValidator[T]) fallback(value T) error {
+	switch v.config.FallbackMode {
+	case FailOpen:
+		return nil
+	case Degrade:
+		if v.config.Fallback != nil {
+			return v.config.Fallback(value)
+		}
+	}
+	return ErrCircuitOpen
+}
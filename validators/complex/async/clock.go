@@ -0,0 +1,46 @@
+package complex
+
+import (
+	"context"
+	"time"
+
+	"github.com/lyonnee/hvalid/validators/primitive"
+)
+
+// Clock 时钟接口，便于在测试中替换真实时间；在 primitive.Clock 的基础上增加等待
+type Clock interface {
+	primitive.Clock
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock 使用系统时间的时钟，当前时间来自 primitive.SystemClock
+type systemClock struct {
+	primitive.Clock
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// SystemClock 系统时钟
+var SystemClock Clock = systemClock{primitive.SystemClock}
+
+// clockOrDefault 未指定时钟时使用系统时钟
+func clockOrDefault(clock Clock) Clock {
+	if clock == nil {
+		return SystemClock
+	}
+	return clock
+}
+
+// sleepContext 在时钟上等待指定时长，上下文取消时提前返回
+func sleepContext(ctx context.Context, clock Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	select {
+	case <-clock.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
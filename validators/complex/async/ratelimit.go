@@ -0,0 +1,283 @@
+package complex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lyonnee/hvalid"
+)
+
+var (
+	// ErrRateLimited 超出速率限制时返回的错误
+	ErrRateLimited = errors.New("validation rate limit exceeded")
+	// ErrQuotaExceeded 超出调用配额时返回的错误
+	ErrQuotaExceeded = errors.New("validation quota exceeded")
+)
+
+// LimitMode 超出限制时的行为
+type LimitMode int
+
+const (
+	// LimitReject 直接返回 ErrRateLimited
+	LimitReject LimitMode = iota
+	// LimitWait 等待令牌，受 MaxWait 和上下文约束
+	LimitWait
+	// LimitFallback 改用 Fallback 指定的验证器
+	LimitFallback
+)
+
+// RateLimit 单个键的限制
+type RateLimit struct {
+	Rate  float64 // 每秒生成的令牌数，必须大于 0
+	Burst int     // 桶容量，小于等于 0 时为 1
+	Quota int64   // 允许的总调用次数，小于等于 0 时不限制
+}
+
+// validate 检查限制是否有效
+func (l RateLimit) validate() error {
+	if !(l.Rate > 0) || math.IsInf(l.Rate, 0) {
+		return fmt.Errorf("rate must be a positive finite number, got %v", l.Rate)
+	}
+	return nil
+}
+
+// defaultIdleTimeout 默认的令牌桶空闲释放时间
+const defaultIdleTimeout = time.Minute
+
+// RateLimitConfig 限流配置
+type RateLimitConfig[T any] struct {
+	RateLimit                                    // 默认限制
+	KeyFunc   func(T) string                     // 限流键，例如租户 ID，为空时所有调用共享一个桶
+	LimitFor  func(key string) (RateLimit, bool) // 按键覆盖默认限制，返回 false 时使用默认限制
+	Mode      LimitMode                          // 超出限制时的行为
+	MaxWait   time.Duration                      // LimitWait 模式下的最长等待时间，小于等于 0 时只受上下文约束
+	Fallback  hvalid.ValidatorFunc[T]            // LimitFallback 模式使用的验证器
+	Clock     Clock                              // 时钟，为空时使用系统时钟
+
+	// IdleTimeout 键空闲多久后释放其令牌桶，小于等于 0 时为 1 分钟；
+	// 只释放已经补满的桶，设置了 Quota 的桶需要保存配额用量，不会被释放
+	IdleTimeout time.Duration
+}
+
+// RateLimitStats 限流统计信息
+type RateLimitStats struct {
+	Allowed  int64 // 放行的调用数
+	Waited   int64 // 等待后放行的调用数
+	Rejected int64 // 被拒绝的调用数
+	Fallback int64 // 改用降级验证器的调用数
+	Keys     int   // 当前跟踪的键数量
+}
+
+// tokenBucket 令牌桶
+type tokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+	used   int64
+}
+
+// RateLimitValidator 限流验证器结构体
+type RateLimitValidator[T any] struct {
+	FieldName string // 字段名称
+	config    RateLimitConfig[T]
+	clock     Clock
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time // 上次清理空闲令牌桶的时间
+
+	allowed  atomic.Int64
+	waited   atomic.Int64
+	rejected atomic.Int64
+	fallback atomic.Int64
+}
+
+// NewRateLimitValidator 创建限流验证器，默认限制无效或 LimitFallback 模式缺少 Fallback 时 panic；
+// LimitFor 返回的无效限制在对应键的调用中返回错误
+func NewRateLimitValidator[T any](fieldName string, config RateLimitConfig[T]) *RateLimitValidator[T] {
+	if err := config.RateLimit.validate(); err != nil {
+		panic("complex: invalid rate limit: " + err.Error())
+	}
+	if config.Mode == LimitFallback && config.Fallback == nil {
+		panic("complex: LimitFallback mode requires a Fallback validator")
+	}
+	if config.IdleTimeout <= 0 {
+		config.IdleTimeout = defaultIdleTimeout
+	}
+
+	clock := clockOrDefault(config.Clock)
+	return &RateLimitValidator[T]{
+		FieldName: fieldName,
+		config:    config,
+		clock:     clock,
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: clock.Now(),
+	}
+}
+
+// WithRateLimit 使用限流执行验证
+func (v *RateLimitValidator[T]) WithRateLimit(validator hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
+	return v.WithRateLimitContext(validator, context.Background())
+}
+
+// WithRateLimitContext 使用限流执行验证，LimitWait 模式下上下文取消时停止等待
+func (v *RateLimitValidator[T]) WithRateLimitContext(validator hvalid.ValidatorFunc[T], ctx context.Context) hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		key := ""
+		if v.config.KeyFunc != nil {
+			key = v.config.KeyFunc(value)
+		}
+
+		wait, err := v.reserve(key)
+		if err != nil {
+			if v.config.Mode == LimitFallback && (errors.Is(err, ErrRateLimited) || errors.Is(err, ErrQuotaExceeded)) {
+				v.fallback.Add(1)
+				return v.config.Fallback(value)
+			}
+			v.rejected.Add(1)
+			return err
+		}
+
+		if wait > 0 {
+			if err := sleepContext(ctx, v.clock, wait); err != nil {
+				v.cancel(key)
+				v.rejected.Add(1)
+				return err
+			}
+			v.waited.Add(1)
+		}
+
+		v.allowed.Add(1)
+		return validator(value)
+	})
+}
+
+// Stats 获取限流统计信息
+func (v *RateLimitValidator[T]) Stats() RateLimitStats {
+	v.mu.Lock()
+	keys := len(v.buckets)
+	v.mu.Unlock()
+
+	return RateLimitStats{
+		Allowed:  v.allowed.Load(),
+		Waited:   v.waited.Load(),
+		Rejected: v.rejected.Load(),
+		Fallback: v.fallback.Load(),
+		Keys:     keys,
+	}
+}
+
+// ResetQuota 重置指定键已使用的配额
+func (v *RateLimitValidator[T]) ResetQuota(key string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if bucket, ok := v.buckets[key]; ok {
+		bucket.used = 0
+	}
+}
+
+// reserve 预留一个令牌，返回需要等待的时间
+func (v *RateLimitValidator[T]) reserve(key string) (time.Duration, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.clock.Now()
+	v.sweepLocked(now)
+	bucket, err := v.bucketLocked(key, now)
+	if err != nil {
+		return 0, err
+	}
+	if bucket.limit.Quota > 0 && bucket.used >= bucket.limit.Quota {
+		return 0, ErrQuotaExceeded
+	}
+
+	bucket.refill(now)
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		bucket.used++
+		return 0, nil
+	}
+	if v.config.Mode != LimitWait {
+		return 0, ErrRateLimited
+	}
+
+	wait := time.Duration((1 - bucket.tokens) / bucket.limit.Rate * float64(time.Second))
+	if v.config.MaxWait > 0 && wait > v.config.MaxWait {
+		return 0, ErrRateLimited
+	}
+
+	// 令牌可以预支为负数，后续调用需要等待更久
+	bucket.tokens--
+	bucket.used++
+	return wait, nil
+}
+
+// cancel 归还预留但未使用的令牌
+func (v *RateLimitValidator[T]) cancel(key string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if bucket, ok := v.buckets[key]; ok {
+		bucket.tokens++
+		bucket.used--
+	}
+}
+
+// bucketLocked 获取或创建指定键的令牌桶
+func (v *RateLimitValidator[T]) bucketLocked(key string, now time.Time) (*tokenBucket, error) {
+	if bucket, ok := v.buckets[key]; ok {
+		return bucket, nil
+	}
+
+	limit := v.config.RateLimit
+	if v.config.LimitFor != nil {
+		if custom, ok := v.config.LimitFor(key); ok {
+			if err := custom.validate(); err != nil {
+				return nil, fmt.Errorf("invalid rate limit for key %q: %w", key, err)
+			}
+			limit = custom
+		}
+	}
+	if limit.Burst <= 0 {
+		limit.Burst = 1
+	}
+
+	bucket := &tokenBucket{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   now,
+	}
+	v.buckets[key] = bucket
+	return bucket, nil
+}
+
+// sweepLocked 每隔 IdleTimeout 释放一次空闲且已补满的令牌桶，释放后重新创建的桶状态相同
+func (v *RateLimitValidator[T]) sweepLocked(now time.Time) {
+	if now.Sub(v.lastSweep) < v.config.IdleTimeout {
+		return
+	}
+	v.lastSweep = now
+
+	for key, bucket := range v.buckets {
+		if bucket.limit.Quota > 0 || now.Sub(bucket.last) < v.config.IdleTimeout {
+			continue
+		}
+		if bucket.refill(now); bucket.tokens >= float64(bucket.limit.Burst) {
+			delete(v.buckets, key)
+		}
+	}
+}
+
+// refill 按经过的时间补充令牌
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed.Seconds()*b.limit.Rate)
+		b.last = now
+	}
+}
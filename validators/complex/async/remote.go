@@ -0,0 +1,197 @@
+package complex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// RemoteRequest 远程验证请求体
+//
+//	{"field": "email", "value": "user@example.com"}
+type RemoteRequest[T any] struct {
+	Field string `json:"field"`
+	Value T      `json:"value"`
+}
+
+// RemoteResponse 远程验证响应体
+//
+//	{"valid": false, "message": "...", "violations": [{"field": "domain", "message": "..."}]}
+//
+// 状态码约定：
+//   - 2xx：按 Valid 判断是否通过
+//   - 422：验证未通过，响应体可选
+//   - 429、5xx 及网络错误：临时性失败，返回 *RemoteError，可被重试、熔断
+//   - 其他 4xx：请求本身有误，返回不可重试的 *RemoteError
+type RemoteResponse struct {
+	Valid      bool              `json:"valid"`
+	Message    string            `json:"message,omitempty"`
+	Violations []RemoteViolation `json:"violations,omitempty"`
+}
+
+// RemoteViolation 远程验证返回的单条违规信息
+type RemoteViolation struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// RemoteError 远程调用失败（非验证未通过）
+type RemoteError struct {
+	StatusCode int   // HTTP 状态码，网络错误时为 0
+	Err        error // 底层错误
+}
+
+// Error 实现 error 接口
+func (e *RemoteError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("remote validation failed with status %d: %v", e.StatusCode, e.Err)
+	}
+	return fmt.Sprintf("remote validation failed: %v", e.Err)
+}
+
+// Unwrap 返回底层错误
+func (e *RemoteError) Unwrap() error {
+	return e.Err
+}
+
+// Temporary 是否为临时性失败
+func (e *RemoteError) Temporary() bool {
+	return e.StatusCode == 0 || e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// IsRemoteFailure 判断错误是否为远程调用失败，可用作 CircuitBreakerConfig.IsFailure
+func IsRemoteFailure(err error) bool {
+	var remoteErr *RemoteError
+	return errors.As(err, &remoteErr)
+}
+
+// RemoteConfig 远程验证配置
+type RemoteConfig struct {
+	Endpoint string        // 验证服务地址
+	Client   *http.Client  // HTTP 客户端，为空时使用 http.DefaultClient
+	Header   http.Header   // 附加请求头
+	Timeout  time.Duration // 单次请求超时，小于等于 0 时只受上下文和客户端约束
+}
+
+// maxRemoteResponseSize 响应体读取上限
+const maxRemoteResponseSize = 1 << 20
+
+// RemoteValidator 远程验证器结构体
+type RemoteValidator[T any] struct {
+	FieldName string // 字段名称
+	config    RemoteConfig
+}
+
+// NewRemoteValidator 创建远程验证器
+func NewRemoteValidator[T any](fieldName string, config RemoteConfig) *RemoteValidator[T] {
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+	return &RemoteValidator[T]{
+		FieldName: fieldName,
+		config:    config,
+	}
+}
+
+// Validate 调用远程服务验证
+func (v *RemoteValidator[T]) Validate() hvalid.ValidatorFunc[T] {
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext 调用远程服务验证，上下文取消时中止请求
+func (v *RemoteValidator[T]) ValidateContext(ctx context.Context) hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		body, err := json.Marshal(RemoteRequest[T]{Field: v.FieldName, Value: value})
+		if err != nil {
+			return Permanent(fmt.Errorf("encode remote validation request: %w", err))
+		}
+
+		// 每次调用使用独立的超时上下文，不能覆盖外层捕获的 ctx
+		reqCtx := ctx
+		if v.config.Timeout > 0 {
+			var cancel context.CancelFunc
+			reqCtx, cancel = context.WithTimeout(ctx, v.config.Timeout)
+			defer cancel()
+		}
+
+		req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, v.config.Endpoint, bytes.NewReader(body))
+		if err != nil {
+			return Permanent(&RemoteError{Err: err})
+		}
+		for key, values := range v.config.Header {
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := v.config.Client.Do(req)
+		if err != nil {
+			return &RemoteError{Err: err}
+		}
+		defer resp.Body.Close()
+
+		data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteResponseSize))
+		if err != nil {
+			return &RemoteError{StatusCode: resp.StatusCode, Err: err}
+		}
+
+		return v.result(resp.StatusCode, data)
+	})
+}
+
+// result 将响应映射为验证结果
+func (v *RemoteValidator[T]) result(statusCode int, data []byte) error {
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		var result RemoteResponse
+		if err := json.Unmarshal(data, &result); err != nil {
+			return &RemoteError{StatusCode: statusCode, Err: fmt.Errorf("decode response: %w", err)}
+		}
+		if result.Valid {
+			return nil
+		}
+		return v.violations(result)
+	case statusCode == http.StatusUnprocessableEntity:
+		var result RemoteResponse
+		if len(bytes.TrimSpace(data)) > 0 {
+			// 422 响应体可选，解析失败时仍按验证未通过处理
+			_ = json.Unmarshal(data, &result)
+		}
+		return v.violations(result)
+	}
+
+	remoteErr := &RemoteError{StatusCode: statusCode, Err: errors.New(http.StatusText(statusCode))}
+	if remoteErr.Temporary() {
+		return remoteErr
+	}
+	return Permanent(remoteErr)
+}
+
+// violations 将验证未通过的响应转换为验证错误
+func (v *RemoteValidator[T]) violations(result RemoteResponse) error {
+	validationErr := hvalid.NewValidationError(v.FieldName)
+
+	if result.Message != "" {
+		validationErr.AddError(result.Message)
+	}
+	for _, violation := range result.Violations {
+		if violation.Field != "" {
+			validationErr.AddError(fmt.Sprintf("%s: %s", violation.Field, violation.Message))
+		} else {
+			validationErr.AddError(violation.Message)
+		}
+	}
+	if !validationErr.HasError() {
+		validationErr.AddError("rejected by remote validator")
+	}
+	return validationErr
+}
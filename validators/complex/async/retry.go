@@ -34,7 +34,7 @@ func (v *RetryValidator[T]) WithRetry(validator hvalid.ValidatorFunc[T], maxRetr
 	})
 }
 
-// WithBackoff 使用指数退避重试机制执行验证
+// WithBackoff 使用指数退避重试机制执行验证，等待时间没有上限且不响应取消，需要更多控制时使用 WithPolicy
 func (v *RetryValidator[T]) WithBackoff(validator hvalid.ValidatorFunc[T], maxRetries int, initialDelay time.Duration) hvalid.ValidatorFunc[T] {
 	return hvalid.ValidatorFunc[T](func(value T) error {
 		var lastErr error
@@ -0,0 +1,212 @@
+package complex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// JitterMode 退避抖动模式
+type JitterMode int
+
+const (
+	// NoJitter 不加抖动
+	NoJitter JitterMode = iota
+	// FullJitter 在 [0, delay) 内随机
+	FullJitter
+	// EqualJitter 在 [delay/2, delay) 内随机
+	EqualJitter
+)
+
+// RetryAttempt 单次尝试的信息，传递给 RetryPolicy.OnRetry
+type RetryAttempt struct {
+	Attempt int           // 第几次尝试，从 1 开始
+	Err     error         // 本次尝试的错误
+	Delay   time.Duration // 下次重试前的等待时间
+	Elapsed time.Duration // 从首次尝试开始经过的时间
+}
+
+// RetryPolicy 重试策略
+type RetryPolicy struct {
+	MaxAttempts  int                // 最大尝试次数（含首次），小于等于 0 时不限制次数，此时应设置 MaxElapsed 或使用可取消的上下文
+	MaxElapsed   time.Duration      // 最长总耗时，小于等于 0 时不限制
+	InitialDelay time.Duration      // 首次重试前的等待时间
+	MaxDelay     time.Duration      // 单次等待时间上限，小于等于 0 时不限制
+	Multiplier   float64            // 退避倍数，小于等于 1 时固定为 InitialDelay
+	Jitter       JitterMode         // 抖动模式
+	Retryable    func(error) bool   // 判断错误是否可重试，为空时所有错误都可重试
+	OnRetry      func(RetryAttempt) // 每次失败后、等待重试前调用
+	Clock        Clock              // 时钟，为空时使用系统时钟
+	Rand         func() float64     // 返回 [0, 1) 的随机数，为空时使用 math/rand
+}
+
+// DefaultRetryPolicy 默认重试策略：最多 3 次尝试，100ms 起步、上限 2s 的全抖动指数退避
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:  3,
+		InitialDelay: 100 * time.Millisecond,
+		MaxDelay:     2 * time.Second,
+		Multiplier:   2,
+		Jitter:       FullJitter,
+	}
+}
+
+// Backoff 计算第 n 次重试（从 1 开始）前的等待时间
+func (p RetryPolicy) Backoff(n int) time.Duration {
+	delay := float64(p.InitialDelay)
+	if p.Multiplier > 1 && n > 1 {
+		delay *= math.Pow(p.Multiplier, float64(n-1))
+	}
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	if delay > math.MaxInt64 {
+		// 先截断为有限值，避免无穷大与 0 相乘得到 NaN
+		delay = math.MaxInt64
+	}
+
+	switch p.Jitter {
+	case FullJitter:
+		delay = delay * p.random()
+	case EqualJitter:
+		delay = delay/2 + delay/2*p.random()
+	}
+
+	// float64(math.MaxInt64) 实际为 2^63，直接转换为 time.Duration 会溢出为负数
+	if delay >= math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// random 返回 [0, 1) 的随机数
+func (p RetryPolicy) random() float64 {
+	if p.Rand != nil {
+		return p.Rand()
+	}
+	return rand.Float64()
+}
+
+// retryable 判断错误是否可重试
+func (p RetryPolicy) retryable(err error) bool {
+	if p.Retryable == nil {
+		return true
+	}
+	return p.Retryable(err)
+}
+
+// RetryError 重试耗尽后返回的错误
+type RetryError struct {
+	Attempts int   // 实际尝试次数
+	Err      error // 最后一次尝试的错误
+}
+
+// Error 实现 error 接口
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("validation failed after %d attempts: %v", e.Attempts, e.Err)
+}
+
+// Unwrap 返回最后一次尝试的错误
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// permanentError 标记为不可重试的错误
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent 将错误标记为不可重试，RetryPolicy 遇到时立即返回
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// RetryOn 错误匹配任意目标（errors.Is）时可重试
+func RetryOn(targets ...error) func(error) bool {
+	return func(err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// RetryOnType 错误链中包含类型 E（errors.As）时可重试
+func RetryOnType[E error]() func(error) bool {
+	return func(err error) bool {
+		var target E
+		return errors.As(err, &target)
+	}
+}
+
+// RetryUnless 错误匹配任意目标（errors.Is）时不可重试，其余错误可重试
+func RetryUnless(targets ...error) func(error) bool {
+	match := RetryOn(targets...)
+	return func(err error) bool {
+		return !match(err)
+	}
+}
+
+// WithPolicy 使用重试策略执行验证
+func (v *RetryValidator[T]) WithPolicy(validator hvalid.ValidatorFunc[T], policy RetryPolicy) hvalid.ValidatorFunc[T] {
+	return v.WithPolicyContext(validator, policy, context.Background())
+}
+
+// WithPolicyContext 使用重试策略执行验证，上下文取消时停止等待并返回
+func (v *RetryValidator[T]) WithPolicyContext(validator hvalid.ValidatorFunc[T], policy RetryPolicy, ctx context.Context) hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		clock := clockOrDefault(policy.Clock)
+		start := clock.Now()
+
+		for attempt := 1; ; attempt++ {
+			err := validator(value)
+			if err == nil {
+				return nil
+			}
+
+			var permanent *permanentError
+			if errors.As(err, &permanent) {
+				return permanent.err
+			}
+			if !policy.retryable(err) {
+				return err
+			}
+			if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+				return &RetryError{Attempts: attempt, Err: err}
+			}
+
+			delay := policy.Backoff(attempt)
+			elapsed := clock.Now().Sub(start)
+			if policy.MaxElapsed > 0 && elapsed+delay > policy.MaxElapsed {
+				return &RetryError{Attempts: attempt, Err: err}
+			}
+
+			if policy.OnRetry != nil {
+				policy.OnRetry(RetryAttempt{
+					Attempt: attempt,
+					Err:     err,
+					Delay:   delay,
+					Elapsed: elapsed,
+				})
+			}
+
+			if ctxErr := sleepContext(ctx, clock, delay); ctxErr != nil {
+				return &RetryError{Attempts: attempt, Err: fmt.Errorf("%w (retry cancelled: %w)", err, ctxErr)}
+			}
+		}
+	})
+}
@@ -2,12 +2,26 @@ package complex
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/lyonnee/hvalid"
 )
 
+// timeoutError 超时错误，可通过 errors.Is 与 context 错误匹配
+type timeoutError struct {
+	msg string
+	err error
+}
+
+func (e *timeoutError) Error() string { return e.msg }
+
+func (e *timeoutError) Unwrap() error { return e.err }
+
+// Timeout 是否由超时引起
+func (e *timeoutError) Timeout() bool { return errors.Is(e.err, context.DeadlineExceeded) }
+
 // TimeoutValidator 超时验证器结构体
 type TimeoutValidator[T any] struct {
 	FieldName string // 字段名称
@@ -35,7 +49,7 @@ func (v *TimeoutValidator[T]) WithTimeout(validator hvalid.ValidatorFunc[T], tim
 		case err := <-errChan:
 			return err
 		case <-ctx.Done():
-			return fmt.Errorf("validation timed out after %v", timeout)
+			return &timeoutError{msg: fmt.Sprintf("validation timed out after %v", timeout), err: ctx.Err()}
 		}
 	})
 }
@@ -55,7 +69,7 @@ func (v *TimeoutValidator[T]) WithDeadline(validator hvalid.ValidatorFunc[T], de
 		case err := <-errChan:
 			return err
 		case <-ctx.Done():
-			return fmt.Errorf("validation deadline exceeded at %v", deadline)
+			return &timeoutError{msg: fmt.Sprintf("validation deadline exceeded at %v", deadline), err: ctx.Err()}
 		}
 	})
 }
@@ -72,7 +86,7 @@ func (v *TimeoutValidator[T]) WithContext(validator hvalid.ValidatorFunc[T], ctx
 		case err := <-errChan:
 			return err
 		case <-ctx.Done():
-			return fmt.Errorf("validation cancelled: %v", ctx.Err())
+			return &timeoutError{msg: fmt.Sprintf("validation cancelled: %v", ctx.Err()), err: ctx.Err()}
 		}
 	})
 }
@@ -1,15 +1,29 @@
 package complex
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
 	"sync"
+	"time"
 
 	"github.com/lyonnee/hvalid"
 )
 
+// KeyFunc 缓存键生成函数，返回的键必须是可比较的类型
+type KeyFunc[T any] func(value T) (any, error)
+
 // CacheValidator 缓存验证器结构体
 type CacheValidator[T any] struct {
-	FieldName string // 字段名称
-	cache     sync.Map
+	FieldName   string           // 字段名称
+	KeyFunc     KeyFunc[T]       // 缓存键生成函数，为空时使用默认策略
+	ShouldCache func(error) bool // 判断验证结果是否写入缓存，为空时全部缓存
+	Store       CacheStore       // 缓存存储后端，为空时使用内存存储
+	TTL         time.Duration    // WithCache 写入缓存的过期时间，小于等于 0 表示永不过期
+	once        sync.Once
 }
 
 // NewCacheValidator 创建缓存验证器
@@ -19,63 +33,228 @@ func NewCacheValidator[T any](fieldName string) *CacheValidator[T] {
 	}
 }
 
-// WithCache 使用缓存执行验证
-func (v *CacheValidator[T]) WithCache(validator hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
-	return hvalid.ValidatorFunc[T](func(value T) error {
-		// 尝试从缓存中获取结果
-		if cachedErr, ok := v.cache.Load(value); ok {
-			if err, ok := cachedErr.(error); ok {
-				return err
-			}
+// NewCacheValidatorWithStore 创建使用指定存储后端的缓存验证器
+func NewCacheValidatorWithStore[T any](fieldName string, store CacheStore) *CacheValidator[T] {
+	return &CacheValidator[T]{
+		FieldName: fieldName,
+		Store:     store,
+	}
+}
+
+// NewCacheValidatorWithKey 创建使用自定义缓存键的缓存验证器
+func NewCacheValidatorWithKey[T any](fieldName string, keyFunc KeyFunc[T]) *CacheValidator[T] {
+	return &CacheValidator[T]{
+		FieldName: fieldName,
+		KeyFunc:   keyFunc,
+	}
+}
+
+// JSONHashKey 使用规范化 JSON 的 SHA-256 哈希作为缓存键
+//
+// JSON 编码会忽略未导出字段和 json:"-" 字段，只有验证结果完全由 JSON 可见内容决定时才能使用，
+// 否则这些字段不同的值会共享缓存结果；默认策略使用覆盖完整内容的 ContentHashKey。
+func JSONHashKey[T any]() KeyFunc[T] {
+	return func(value T) (any, error) {
+		// encoding/json 对 map 键进行排序，保证相同内容得到相同输出
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
 		}
+		return hashKey(sha256.Sum256(data)), nil
+	}
+}
 
-		// 执行验证
-		err := validator(value)
+// SkipTransientErrors 不缓存超时、取消等临时性失败
+func SkipTransientErrors(err error) bool {
+	return !IsTransientError(err)
+}
 
-		// 缓存结果
-		v.cache.Store(value, err)
+// IsTransientError 判断错误是否为临时性失败
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return true
+	}
 
-		return err
-	})
+	var timeoutErr interface{ Timeout() bool }
+	if errors.As(err, &timeoutErr) && timeoutErr.Timeout() {
+		return true
+	}
+
+	var temporaryErr interface{ Temporary() bool }
+	return errors.As(err, &temporaryErr) && temporaryErr.Temporary()
 }
 
-// WithTTL 使用带过期时间的缓存执行验证
+// WithCache 使用缓存执行验证
+//
+// 存储后端读写失败时退化为直接执行验证，不影响验证结果。
+func (v *CacheValidator[T]) WithCache(validator hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
+	return v.withTTL(validator, v.TTL)
+}
+
+// WithTTL 使用带过期时间的缓存执行验证，ttl 单位为秒，小于等于 0 时不缓存
 func (v *CacheValidator[T]) WithTTL(validator hvalid.ValidatorFunc[T], ttl int64) hvalid.ValidatorFunc[T] {
+	if ttl <= 0 {
+		return validator
+	}
+	return v.withTTL(validator, time.Duration(ttl)*time.Second)
+}
+
+// withTTL 使用缓存执行验证，ttl 小于等于 0 表示永不过期
+func (v *CacheValidator[T]) withTTL(validator hvalid.ValidatorFunc[T], ttl time.Duration) hvalid.ValidatorFunc[T] {
 	return hvalid.ValidatorFunc[T](func(value T) error {
+		key, keyErr := v.key(value)
+		if keyErr != nil {
+			// 无法生成缓存键时直接执行验证
+			return validator(value)
+		}
+
 		// 尝试从缓存中获取结果
-		if cachedResult, ok := v.cache.Load(value); ok {
-			if result, ok := cachedResult.(struct {
-				err error
-				ttl int64
-			}); ok {
-				if result.ttl > 0 {
-					return result.err
-				}
-			}
+		store := v.store()
+		if cached, ok, err := store.Get(key); err == nil && ok {
+			return cached.Err()
 		}
 
 		// 执行验证
 		err := validator(value)
 
 		// 缓存结果
-		v.cache.Store(value, struct {
-			err error
-			ttl int64
-		}{
-			err: err,
-			ttl: ttl,
-		})
+		if v.shouldCache(err) {
+			_ = store.Set(key, EncodeCachedResult(err), ttl)
+		}
 
 		return err
 	})
 }
 
-// ClearCache 清除缓存
+// ClearCache 清除缓存，仅对支持清除的存储后端生效
 func (v *CacheValidator[T]) ClearCache() {
-	v.cache = sync.Map{}
+	if clearer, ok := v.store().(interface{ Clear() error }); ok {
+		_ = clearer.Clear()
+	}
 }
 
 // RemoveFromCache 从缓存中移除特定值
 func (v *CacheValidator[T]) RemoveFromCache(value T) {
-	v.cache.Delete(value)
+	if key, err := v.key(value); err == nil {
+		_ = v.store().Delete(key)
+	}
+}
+
+// store 获取存储后端，未设置时初始化内存存储
+func (v *CacheValidator[T]) store() CacheStore {
+	v.once.Do(func() {
+		if v.Store == nil {
+			v.Store = NewMemoryCacheStore()
+		}
+	})
+	return v.Store
+}
+
+// key 生成缓存键
+func (v *CacheValidator[T]) key(value T) (string, error) {
+	return valueKey(v.KeyFunc, value, isPersistent(v.store()))
+}
+
+// valueKey 使用 keyFunc 生成字符串键，keyFunc 为空时使用默认策略；
+// persistent 为 true 时拒绝含指针等地址的键，这类键在进程重启后没有意义
+func valueKey[T any](keyFunc KeyFunc[T], value T, persistent bool) (string, error) {
+	if keyFunc != nil {
+		key, err := keyFunc(value)
+		if err != nil {
+			return "", err
+		}
+		if key == nil || !isHashable(reflect.ValueOf(key)) {
+			return "", fmt.Errorf("cache key of type %T is not comparable", key)
+		}
+		if persistent && !isStableKey(reflect.ValueOf(key)) {
+			return "", fmt.Errorf("cache key of type %T cannot be persisted", key)
+		}
+		return formatKey(key), nil
+	}
+
+	// 默认策略：不含指针的可比较值直接作为键，否则使用完整内容的哈希；
+	// 含指针的值按地址比较，指向的内容被修改后仍会命中旧的结果
+	var key any = value
+	if key == nil || !isHashable(reflect.ValueOf(key)) || !isStableKey(reflect.ValueOf(key)) {
+		var err error
+		if key, err = ContentHashKey[T]()(value); err != nil {
+			return "", err
+		}
+	}
+	return formatKey(key), nil
+}
+
+// formatKey 将缓存键转换为存储后端使用的字符串
+func formatKey(key any) string {
+	switch k := key.(type) {
+	case hashKey:
+		return fmt.Sprintf("sha256:%x", k[:])
+	case string:
+		return "string:" + k
+	default:
+		return fmt.Sprintf("%T:%#v", k, k)
+	}
+}
+
+// isPersistent 判断存储后端是否跨进程持久化
+func isPersistent(store CacheStore) bool {
+	p, ok := store.(interface{ Persistent() bool })
+	return ok && p.Persistent()
+}
+
+// shouldCache 判断验证结果是否需要缓存
+func (v *CacheValidator[T]) shouldCache(err error) bool {
+	if v.ShouldCache == nil {
+		return true
+	}
+	return v.ShouldCache(err)
+}
+
+// isHashable 检查值能否作为 map 键而不触发运行时 panic
+func isHashable(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Func:
+		return false
+	case reflect.Interface:
+		return rv.IsNil() || isHashable(rv.Elem())
+	case reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if !isHashable(rv.Index(i)) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			if !isHashable(rv.Field(i)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isStableKey 检查键是否只由值组成，不含指针、通道等按地址比较的部分
+func isStableKey(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer, reflect.Func:
+		return false
+	case reflect.Interface:
+		return rv.IsNil() || isStableKey(rv.Elem())
+	case reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if !isStableKey(rv.Index(i)) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			if !isStableKey(rv.Field(i)) {
+				return false
+			}
+		}
+	}
+	return true
 }
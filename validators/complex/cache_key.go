@@ -0,0 +1,190 @@
+package complex
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// maxContentDepth 内容哈希允许的最大嵌套深度，用于拒绝经由切片或 map 形成的循环引用
+const maxContentDepth = 512
+
+// hashKey 默认策略下不可比较值的哈希键
+type hashKey [sha256.Size]byte
+
+// ContentHashKey 使用值完整内容的 SHA-256 哈希作为缓存键
+//
+// 编码覆盖所有字段（包括未导出字段和 json:"-" 字段）、指针指向的值和接口的动态类型，
+// map 按键的编码排序，因此内容相同的值得到相同的键，内容不同的值几乎不可能碰撞。
+// 包含函数、通道、unsafe.Pointer 或循环引用的值无法生成键。
+func ContentHashKey[T any]() KeyFunc[T] {
+	return func(value T) (any, error) {
+		e := contentEncoder{visiting: make(map[uintptr]bool)}
+		rv := reflect.ValueOf(&value).Elem()
+		e.writeType(rv.Type())
+		if err := e.encode(rv, 0); err != nil {
+			return nil, err
+		}
+		return hashKey(sha256.Sum256(e.buf.Bytes())), nil
+	}
+}
+
+// contentEncoder 将值编码为无歧义的字节序列，每个值都带有类型标记和长度前缀
+type contentEncoder struct {
+	buf      bytes.Buffer
+	visiting map[uintptr]bool // 正在编码的指针，用于检测循环引用
+}
+
+// encode 编码一个值
+func (e *contentEncoder) encode(rv reflect.Value, depth int) error {
+	if depth > maxContentDepth {
+		return fmt.Errorf("cannot hash value: nested deeper than %d levels", maxContentDepth)
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		e.buf.WriteByte('b')
+		if rv.Bool() {
+			e.buf.WriteByte(1)
+		} else {
+			e.buf.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.buf.WriteByte('i')
+		e.writeUint(uint64(rv.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.buf.WriteByte('u')
+		e.writeUint(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		e.buf.WriteByte('f')
+		e.writeUint(math.Float64bits(rv.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		e.buf.WriteByte('c')
+		e.writeUint(math.Float64bits(real(rv.Complex())))
+		e.writeUint(math.Float64bits(imag(rv.Complex())))
+	case reflect.String:
+		e.buf.WriteByte('s')
+		e.writeString(rv.String())
+	case reflect.Slice:
+		e.buf.WriteByte('S')
+		if rv.IsNil() {
+			e.buf.WriteByte(0)
+			return nil
+		}
+		e.buf.WriteByte(1)
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			e.writeUint(uint64(rv.Len()))
+			e.buf.Write(rv.Bytes())
+			return nil
+		}
+		return e.encodeElems(rv, depth)
+	case reflect.Array:
+		e.buf.WriteByte('A')
+		return e.encodeElems(rv, depth)
+	case reflect.Map:
+		e.buf.WriteByte('M')
+		if rv.IsNil() {
+			e.buf.WriteByte(0)
+			return nil
+		}
+		e.buf.WriteByte(1)
+		return e.encodeMap(rv, depth)
+	case reflect.Struct:
+		e.buf.WriteByte('T')
+		e.writeUint(uint64(rv.NumField()))
+		for i := 0; i < rv.NumField(); i++ {
+			e.writeString(rv.Type().Field(i).Name)
+			if err := e.encode(rv.Field(i), depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Pointer:
+		e.buf.WriteByte('P')
+		if rv.IsNil() {
+			e.buf.WriteByte(0)
+			return nil
+		}
+		e.buf.WriteByte(1)
+		ptr := rv.Pointer()
+		if e.visiting[ptr] {
+			return fmt.Errorf("cannot hash value of type %s: cyclic reference", rv.Type())
+		}
+		e.visiting[ptr] = true
+		defer delete(e.visiting, ptr)
+		return e.encode(rv.Elem(), depth+1)
+	case reflect.Interface:
+		e.buf.WriteByte('I')
+		if rv.IsNil() {
+			e.buf.WriteByte(0)
+			return nil
+		}
+		e.buf.WriteByte(1)
+		e.writeType(rv.Elem().Type())
+		return e.encode(rv.Elem(), depth+1)
+	default:
+		return fmt.Errorf("cannot hash value of type %s", rv.Type())
+	}
+	return nil
+}
+
+// encodeElems 编码切片或数组的元素
+func (e *contentEncoder) encodeElems(rv reflect.Value, depth int) error {
+	e.writeUint(uint64(rv.Len()))
+	for i := 0; i < rv.Len(); i++ {
+		if err := e.encode(rv.Index(i), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeMap 编码 map，键值对按键的编码排序
+func (e *contentEncoder) encodeMap(rv reflect.Value, depth int) error {
+	type entry struct{ key, value []byte }
+
+	entries := make([]entry, 0, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		var kv [2][]byte
+		for i, v := range []reflect.Value{iter.Key(), iter.Value()} {
+			sub := contentEncoder{visiting: e.visiting}
+			if err := sub.encode(v, depth+1); err != nil {
+				return err
+			}
+			kv[i] = sub.buf.Bytes()
+		}
+		entries = append(entries, entry{key: kv[0], value: kv[1]})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	e.writeUint(uint64(len(entries)))
+	for _, entry := range entries {
+		e.buf.Write(entry.key)
+		e.buf.Write(entry.value)
+	}
+	return nil
+}
+
+// writeType 写入类型的完整名称，包含包路径以区分不同包中的同名类型
+func (e *contentEncoder) writeType(t reflect.Type) {
+	e.writeString(t.PkgPath() + "." + t.String())
+}
+
+// writeString 写入带长度前缀的字符串
+func (e *contentEncoder) writeString(s string) {
+	e.writeUint(uint64(len(s)))
+	e.buf.WriteString(s)
+}
+
+// writeUint 以大端序写入 8 字节整数
+func (e *contentEncoder) writeUint(n uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], n)
+	e.buf.Write(b[:])
+}
@@ -0,0 +1,505 @@
+package complex
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// CacheStore 缓存存储后端接口
+//
+// 跨进程持久化的后端应实现 Persistent() bool 并返回 true，CacheValidator 会拒绝写入含指针等地址的自定义键；
+// 支持清空的后端可以实现 Clear() error，供 ClearCache 使用。
+type CacheStore interface {
+	// Get 获取缓存结果，不存在或已过期时返回 false
+	Get(key string) (CachedResult, bool, error)
+	// Set 写入缓存结果，ttl 小于等于 0 表示永不过期
+	Set(key string, result CachedResult, ttl time.Duration) error
+	// Delete 删除缓存结果
+	Delete(key string) error
+}
+
+// CachedResult 缓存的验证结果，是验证错误的序列化形式
+//
+// 约定：
+//   - Valid 为 true 表示验证通过，其余字段为空
+//   - *hvalid.ValidationError 保存为 Field 和 Errors，还原后仍为 *hvalid.ValidationError
+//   - 其他错误只保存 Message，还原后为 errors.New(Message)，原错误类型不会保留
+type CachedResult struct {
+	Valid   bool     `json:"valid"`
+	Field   string   `json:"field,omitempty"`
+	Errors  []string `json:"errors,omitempty"`
+	Message string   `json:"message,omitempty"`
+}
+
+// EncodeCachedResult 将验证结果编码为可缓存的形式
+func EncodeCachedResult(err error) CachedResult {
+	if err == nil {
+		return CachedResult{Valid: true}
+	}
+
+	var validationErr *hvalid.ValidationError
+	if errors.As(err, &validationErr) && validationErr.Error() == err.Error() {
+		return CachedResult{
+			Field:  validationErr.Field,
+			Errors: append([]string(nil), validationErr.Errors...),
+		}
+	}
+
+	return CachedResult{Message: err.Error()}
+}
+
+// Err 将缓存结果还原为验证错误
+func (r CachedResult) Err() error {
+	if r.Valid {
+		return nil
+	}
+	if len(r.Errors) > 0 {
+		validationErr := hvalid.NewValidationError(r.Field)
+		for _, msg := range r.Errors {
+			validationErr.AddError(msg)
+		}
+		return validationErr
+	}
+	return errors.New(r.Message)
+}
+
+// memoryEntry 内存缓存条目
+type memoryEntry struct {
+	result    CachedResult
+	expiresAt time.Time
+}
+
+// expired 检查条目是否过期
+func (e memoryEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// minSweepSize 条目数达到该值后才开始清理过期条目
+const minSweepSize = 64
+
+// sweepExpired 删除所有过期条目，返回下次清理时的条目数
+//
+// 条目数翻倍时才清理一次，清理的开销分摊到每次写入上，未被读取的过期条目最多占用与有效条目相当的空间。
+func sweepExpired(entries map[string]memoryEntry, now time.Time) int {
+	for key, entry := range entries {
+		if entry.expired(now) {
+			delete(entries, key)
+		}
+	}
+	if next := 2 * len(entries); next > minSweepSize {
+		return next
+	}
+	return minSweepSize
+}
+
+// MemoryCacheStore 内存缓存存储
+//
+// 过期条目在读取时删除，写入时也会定期清理，不再读取的键不会一直占用内存。
+type MemoryCacheStore struct {
+	mu      sync.RWMutex
+	entries map[string]memoryEntry
+	sweepAt int // 条目数达到该值时清理过期条目
+}
+
+// NewMemoryCacheStore 创建内存缓存存储
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{
+		entries: make(map[string]memoryEntry),
+		sweepAt: minSweepSize,
+	}
+}
+
+// Get 获取缓存结果
+func (s *MemoryCacheStore) Get(key string) (CachedResult, bool, error) {
+	s.mu.RLock()
+	entry, ok := s.entries[key]
+	s.mu.RUnlock()
+
+	if !ok {
+		return CachedResult{}, false, nil
+	}
+	if entry.expired(time.Now()) {
+		s.mu.Lock()
+		if current, ok := s.entries[key]; ok && current.expired(time.Now()) {
+			delete(s.entries, key)
+		}
+		s.mu.Unlock()
+		return CachedResult{}, false, nil
+	}
+	return entry.result, true, nil
+}
+
+// Set 写入缓存结果
+func (s *MemoryCacheStore) Set(key string, result CachedResult, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.entries) >= s.sweepAt {
+		s.sweepAt = sweepExpired(s.entries, time.Now())
+	}
+	s.entries[key] = memoryEntry{result: result, expiresAt: expiresAt(ttl)}
+	return nil
+}
+
+// Delete 删除缓存结果
+func (s *MemoryCacheStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// Clear 清除所有缓存结果
+func (s *MemoryCacheStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = make(map[string]memoryEntry)
+	s.sweepAt = minSweepSize
+	return nil
+}
+
+// fileRecord 文件缓存中的一条追加记录
+type fileRecord struct {
+	Op        string        `json:"op"`
+	Key       string        `json:"key"`
+	Result    *CachedResult `json:"result,omitempty"`
+	ExpiresAt int64         `json:"expires_at,omitempty"` // Unix 纳秒时间戳，0 表示永不过期
+}
+
+// 文件记录操作类型
+const (
+	fileOpSet    = "set"
+	fileOpDelete = "del"
+)
+
+// minCompactRecords 文件中的记录数达到该值后才自动压缩
+const minCompactRecords = 1024
+
+// FileCacheStore 基于追加写文件的缓存存储
+//
+// 每次写入都以一行 JSON 追加到文件末尾，启动时重放文件重建索引。
+// 多个进程可以共享同一个文件：未命中时会读取其他进程新追加的记录。
+// 写入时定期清理过期条目，文件中的记录数超过有效条目的两倍时自动压缩；
+// 其他进程发现文件被压缩替换后会重新打开并重放。
+type FileCacheStore struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	offset  int64 // 已读取到的文件位置
+	records int   // 文件中的记录数
+	entries map[string]memoryEntry
+	sweepAt int // 条目数达到该值时清理过期条目
+}
+
+// NewFileCacheStore 打开或创建文件缓存存储
+func NewFileCacheStore(path string) (*FileCacheStore, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileCacheStore{
+		path:    path,
+		file:    file,
+		entries: make(map[string]memoryEntry),
+		sweepAt: minSweepSize,
+	}
+	if err := s.load(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Get 获取缓存结果
+func (s *FileCacheStore) Get(key string) (CachedResult, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		// 读取其他进程追加的记录
+		if err := s.load(); err != nil {
+			return CachedResult{}, false, err
+		}
+		if entry, ok = s.entries[key]; !ok {
+			return CachedResult{}, false, nil
+		}
+	}
+
+	if entry.expired(time.Now()) {
+		delete(s.entries, key)
+		return CachedResult{}, false, nil
+	}
+	return entry.result, true, nil
+}
+
+// Set 写入缓存结果
+func (s *FileCacheStore) Set(key string, result CachedResult, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := memoryEntry{result: result, expiresAt: expiresAt(ttl)}
+	record := fileRecord{Op: fileOpSet, Key: key, Result: &result}
+	if !entry.expiresAt.IsZero() {
+		record.ExpiresAt = entry.expiresAt.UnixNano()
+	}
+
+	if err := s.append(record); err != nil {
+		return err
+	}
+	if len(s.entries) >= s.sweepAt {
+		s.sweepAt = sweepExpired(s.entries, time.Now())
+	}
+	s.entries[key] = entry
+	return s.maybeCompact()
+}
+
+// Delete 删除缓存结果
+func (s *FileCacheStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.append(fileRecord{Op: fileOpDelete, Key: key}); err != nil {
+		return err
+	}
+	delete(s.entries, key)
+	return s.maybeCompact()
+}
+
+// Clear 清空缓存文件和索引
+//
+// 共享同一文件的其他进程在下次读取时发现文件变短，会丢弃各自的索引并从头重放。
+func (s *FileCacheStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.file.Truncate(0); err != nil {
+		return err
+	}
+	s.offset, s.records = 0, 0
+	s.entries = make(map[string]memoryEntry)
+	s.sweepAt = minSweepSize
+	return nil
+}
+
+// Persistent 文件缓存跨进程持久化
+func (s *FileCacheStore) Persistent() bool {
+	return true
+}
+
+// Compact 重写缓存文件，只保留未过期的条目
+//
+// 压缩期间其他进程追加的记录可能丢失，只会造成缓存未命中。
+func (s *FileCacheStore) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.compactLocked()
+}
+
+// maybeCompact 文件中的无效记录过多时压缩
+func (s *FileCacheStore) maybeCompact() error {
+	if s.records < minCompactRecords || s.records <= 2*len(s.entries) {
+		return nil
+	}
+	return s.compactLocked()
+}
+
+// compactLocked 重写缓存文件，调用方必须持有锁
+func (s *FileCacheStore) compactLocked() error {
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	now := time.Now()
+	w := bufio.NewWriter(tmp)
+	for key, entry := range s.entries {
+		if entry.expired(now) {
+			delete(s.entries, key)
+			continue
+		}
+
+		result := entry.result
+		record := fileRecord{Op: fileOpSet, Key: key, Result: &result}
+		if !entry.expiresAt.IsZero() {
+			record.ExpiresAt = entry.expiresAt.UnixNano()
+		}
+		if err := writeRecord(w, record); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	s.file.Close()
+	s.file = file
+	s.records = len(s.entries)
+	s.offset, err = file.Seek(0, io.SeekEnd)
+	return err
+}
+
+// Close 关闭缓存文件
+func (s *FileCacheStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// append 追加一条记录
+func (s *FileCacheStore) append(record fileRecord) error {
+	if err := s.reopenIfReplaced(); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := writeRecord(&buf, record); err != nil {
+		return err
+	}
+	if _, err := s.file.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	s.records++
+	return nil
+}
+
+// reopenIfReplaced 文件被其他进程压缩替换时重新打开，并丢弃已有索引从头重放
+func (s *FileCacheStore) reopenIfReplaced() error {
+	current, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+	opened, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+	if os.SameFile(current, opened) {
+		return nil
+	}
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	s.file.Close()
+	s.file = file
+	s.offset, s.records = 0, 0
+	s.entries = make(map[string]memoryEntry)
+	return nil
+}
+
+// load 从上次读取的位置开始重放文件中的记录
+func (s *FileCacheStore) load() error {
+	if err := s.reopenIfReplaced(); err != nil {
+		return err
+	}
+
+	info, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() < s.offset {
+		// 文件被其他进程清空，丢弃已有索引
+		s.offset, s.records = 0, 0
+		s.entries = make(map[string]memoryEntry)
+	}
+
+	if _, err := s.file.Seek(s.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	data, err := io.ReadAll(s.file)
+	if err != nil {
+		return err
+	}
+
+	// 只处理完整的行，未写完的记录留到下次读取
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		return nil
+	}
+
+	for _, line := range bytes.Split(data[:end], []byte{'\n'}) {
+		if len(line) == 0 {
+			continue
+		}
+
+		var record fileRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			// 跳过损坏的记录
+			continue
+		}
+		s.records++
+
+		switch record.Op {
+		case fileOpSet:
+			if record.Result == nil {
+				continue
+			}
+			entry := memoryEntry{result: *record.Result}
+			if record.ExpiresAt > 0 {
+				entry.expiresAt = time.Unix(0, record.ExpiresAt)
+			}
+			s.entries[record.Key] = entry
+		case fileOpDelete:
+			delete(s.entries, record.Key)
+		}
+	}
+
+	s.offset += int64(end + 1)
+	return nil
+}
+
+// writeRecord 以一行 JSON 写入记录
+func writeRecord(w io.Writer, record fileRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// expiresAt 计算过期时间，ttl 小于等于 0 时返回零值
+func expiresAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(ttl)
+}
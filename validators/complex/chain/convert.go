@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/lyonnee/hvalid"
+	"github.com/lyonnee/hvalid/validators/primitive"
 )
 
 // ConvertValidator 转换验证器结构体
@@ -45,7 +46,7 @@ func (v *ConvertValidator[T, U]) ConvertSlice(convert func(T) U, validator hvali
 		for i, value := range values {
 			converted := convert(value)
 			if err := validator(converted); err != nil {
-				validationErr.AddError(fmt.Sprintf("element[%d]: %v", i, err))
+				primitive.AddPathError(validationErr, primitive.ElementPath(i), err)
 			}
 		}
 
@@ -56,15 +57,23 @@ func (v *ConvertValidator[T, U]) ConvertSlice(convert func(T) U, validator hvali
 	})
 }
 
-// ConvertMap 转换映射中的值后验证
+// ConvertMap 转换 map 中的每个值后验证，其他键类型使用包级函数 ConvertMap
 func (v *ConvertValidator[T, U]) ConvertMap(convert func(T) U, validator hvalid.ValidatorFunc[U]) hvalid.ValidatorFunc[map[string]T] {
-	return hvalid.ValidatorFunc[map[string]T](func(values map[string]T) error {
-		validationErr := hvalid.NewValidationError(v.FieldName)
+	return ConvertMap[string](v.FieldName, convert, validator)
+}
 
-		for key, value := range values {
-			converted := convert(value)
+// ConvertMap 转换 map 中的每个值后验证，错误信息按键排序并带有键路径，例如 key["abc"]
+//
+// 键的类型无法从参数推断，需要显式指定：ConvertMap[string]("tags", strings.TrimSpace, validator)。
+// 键本身使用 primitive.MapValidator 的 EachKey 验证。
+func ConvertMap[K comparable, T, U any](fieldName string, convert func(T) U, validator hvalid.ValidatorFunc[U]) hvalid.ValidatorFunc[map[K]T] {
+	return hvalid.ValidatorFunc[map[K]T](func(values map[K]T) error {
+		validationErr := hvalid.NewValidationError(fieldName)
+
+		for _, key := range primitive.SortedKeys(values) {
+			converted := convert(values[key])
 			if err := validator(converted); err != nil {
-				validationErr.AddError(fmt.Sprintf("key[%s]: %v", key, err))
+				primitive.AddPathError(validationErr, primitive.KeyPath(key), err)
 			}
 		}
 
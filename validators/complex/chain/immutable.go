@@ -0,0 +1,171 @@
+package complex
+
+import (
+	"fmt"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// Step 链中的命名步骤
+type Step[T any] struct {
+	Name          string                  // 步骤名称
+	Validator     hvalid.ValidatorFunc[T] // 验证器
+	StopOnFailure bool                    // 失败后是否停止执行后续步骤
+}
+
+// NamedStep 创建命名步骤
+func NamedStep[T any](name string, validator hvalid.ValidatorFunc[T]) Step[T] {
+	return Step[T]{
+		Name:      name,
+		Validator: validator,
+	}
+}
+
+// Stop 返回失败后停止执行后续步骤的副本
+func (s Step[T]) Stop() Step[T] {
+	s.StopOnFailure = true
+	return s
+}
+
+// Chain 不可变的验证链
+//
+// 所有修改操作都返回新的链，原链保持不变，可以安全地在多个 goroutine 间共享和扩展。
+// 步骤名称按首次出现匹配，建议在同一链中保持唯一。
+type Chain[T any] struct {
+	fieldName string
+	steps     []Step[T]
+}
+
+// NewChain 创建不可变验证链
+func NewChain[T any](fieldName string, steps ...Step[T]) *Chain[T] {
+	return &Chain[T]{
+		fieldName: fieldName,
+		steps:     append([]Step[T](nil), steps...),
+	}
+}
+
+// Freeze 将当前的链式验证器转换为不可变验证链，步骤依次命名为 step0、step1……
+func (v *ChainValidator[T]) Freeze() *Chain[T] {
+	steps := make([]Step[T], len(v.validators))
+	for i, validator := range v.validators {
+		steps[i] = NamedStep(fmt.Sprintf("step%d", i), validator)
+	}
+	return NewChain(v.FieldName, steps...)
+}
+
+// FieldName 获取字段名称
+func (c *Chain[T]) FieldName() string {
+	return c.fieldName
+}
+
+// Len 获取步骤数量
+func (c *Chain[T]) Len() int {
+	return len(c.steps)
+}
+
+// Names 获取所有步骤名称
+func (c *Chain[T]) Names() []string {
+	names := make([]string, len(c.steps))
+	for i, step := range c.steps {
+		names[i] = step.Name
+	}
+	return names
+}
+
+// Steps 获取所有步骤的副本
+func (c *Chain[T]) Steps() []Step[T] {
+	return append([]Step[T](nil), c.steps...)
+}
+
+// With 返回在末尾追加步骤的新链
+func (c *Chain[T]) With(steps ...Step[T]) *Chain[T] {
+	newSteps := make([]Step[T], 0, len(c.steps)+len(steps))
+	newSteps = append(newSteps, c.steps...)
+	newSteps = append(newSteps, steps...)
+	return &Chain[T]{fieldName: c.fieldName, steps: newSteps}
+}
+
+// Without 返回移除指定名称步骤的新链
+func (c *Chain[T]) Without(names ...string) *Chain[T] {
+	remove := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		remove[name] = struct{}{}
+	}
+
+	newSteps := make([]Step[T], 0, len(c.steps))
+	for _, step := range c.steps {
+		if _, ok := remove[step.Name]; !ok {
+			newSteps = append(newSteps, step)
+		}
+	}
+	return &Chain[T]{fieldName: c.fieldName, steps: newSteps}
+}
+
+// InsertBefore 返回在指定步骤之前插入步骤的新链，步骤不存在时返回错误
+func (c *Chain[T]) InsertBefore(name string, steps ...Step[T]) (*Chain[T], error) {
+	i := c.index(name)
+	if i < 0 {
+		return nil, fmt.Errorf("step not found: %s", name)
+	}
+
+	newSteps := make([]Step[T], 0, len(c.steps)+len(steps))
+	newSteps = append(newSteps, c.steps[:i]...)
+	newSteps = append(newSteps, steps...)
+	newSteps = append(newSteps, c.steps[i:]...)
+	return &Chain[T]{fieldName: c.fieldName, steps: newSteps}, nil
+}
+
+// Replace 返回替换指定步骤的新链，步骤不存在时返回错误
+func (c *Chain[T]) Replace(name string, step Step[T]) (*Chain[T], error) {
+	i := c.index(name)
+	if i < 0 {
+		return nil, fmt.Errorf("step not found: %s", name)
+	}
+
+	newSteps := append([]Step[T](nil), c.steps...)
+	newSteps[i] = step
+	return &Chain[T]{fieldName: c.fieldName, steps: newSteps}, nil
+}
+
+// Concat 返回依次连接其他链的新链，字段名称沿用当前链
+func (c *Chain[T]) Concat(others ...*Chain[T]) *Chain[T] {
+	newSteps := append([]Step[T](nil), c.steps...)
+	for _, other := range others {
+		newSteps = append(newSteps, other.steps...)
+	}
+	return &Chain[T]{fieldName: c.fieldName, steps: newSteps}
+}
+
+// Validate 依次执行所有步骤，错误信息带有步骤名称
+func (c *Chain[T]) Validate(value T) error {
+	validationErr := hvalid.NewValidationError(c.fieldName)
+
+	for _, step := range c.steps {
+		if err := step.Validator(value); err != nil {
+			validationErr.AddError(fmt.Sprintf("step[%s]: %v", step.Name, err))
+			if step.StopOnFailure {
+				break
+			}
+		}
+	}
+
+	if validationErr.HasError() {
+		return validationErr
+	}
+	return nil
+}
+
+// ValidatorFunc 将验证链转换为验证函数
+func (c *Chain[T]) ValidatorFunc() hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](c.Validate)
+}
+
+// index 查找指定名称步骤的位置
+func (c *Chain[T]) index(name string) int {
+	for i, step := range c.steps {
+		if step.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,149 @@
+package complex
+
+import (
+	"fmt"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// StageError 管道中某个阶段失败时返回的错误
+//
+// 可通过 errors.As 取得 *hvalid.ValidationError，也可通过 errors.Is/As 匹配阶段返回的原始错误。
+type StageError struct {
+	Stage      string // 失败的阶段名称
+	Err        error  // 阶段返回的原始错误
+	validation *hvalid.ValidationError
+}
+
+// Error 实现 error 接口
+func (e *StageError) Error() string {
+	return e.validation.Error()
+}
+
+// Unwrap 返回验证错误和原始错误
+func (e *StageError) Unwrap() []error {
+	return []error{e.validation, e.Err}
+}
+
+// Pipe 类型化管道，将 In 依次解析、规范化、验证后得到 Out
+//
+// 管道是不可变的，每次追加阶段都返回新的管道。跨类型的阶段通过 Then 和 Compose 追加。
+type Pipe[In, Out any] struct {
+	fieldName string
+	stages    []string
+	run       func(In) (Out, error)
+}
+
+// NewPipe 创建输入输出类型相同的空管道
+func NewPipe[T any](fieldName string) *Pipe[T, T] {
+	return &Pipe[T, T]{
+		fieldName: fieldName,
+		run:       func(value T) (T, error) { return value, nil },
+	}
+}
+
+// Parse 创建以解析阶段开头的管道
+func Parse[In, Out any](fieldName, stage string, parse func(In) (Out, error)) *Pipe[In, Out] {
+	return Then(NewPipe[In](fieldName), stage, parse)
+}
+
+// Then 追加一个可能改变类型的阶段
+func Then[A, B, C any](p *Pipe[A, B], stage string, fn func(B) (C, error)) *Pipe[A, C] {
+	run := p.run
+	return &Pipe[A, C]{
+		fieldName: p.fieldName,
+		stages:    appendStage(p.stages, stage),
+		run: func(value A) (C, error) {
+			var zero C
+
+			intermediate, err := run(value)
+			if err != nil {
+				return zero, err
+			}
+
+			result, err := fn(intermediate)
+			if err != nil {
+				return zero, newStageError(p.fieldName, stage, err)
+			}
+			return result, nil
+		},
+	}
+}
+
+// Compose 连接两个管道，后一个管道的阶段失败时仍报告其阶段名称，字段名称沿用前一个管道
+func Compose[A, B, C any](p *Pipe[A, B], q *Pipe[B, C]) *Pipe[A, C] {
+	first, second := p.run, q.run
+	return &Pipe[A, C]{
+		fieldName: p.fieldName,
+		stages:    appendStage(p.stages, q.stages...),
+		run: func(value A) (C, error) {
+			var zero C
+
+			intermediate, err := first(value)
+			if err != nil {
+				return zero, err
+			}
+
+			result, err := second(intermediate)
+			if stageErr, ok := err.(*StageError); ok && q.fieldName != p.fieldName {
+				return zero, newStageError(p.fieldName, stageErr.Stage, stageErr.Err)
+			}
+			return result, err
+		},
+	}
+}
+
+// Map 追加一个不改变类型、不会失败的规范化阶段
+func (p *Pipe[In, Out]) Map(stage string, fn func(Out) Out) *Pipe[In, Out] {
+	return Then(p, stage, func(value Out) (Out, error) {
+		return fn(value), nil
+	})
+}
+
+// Check 追加一个验证阶段，所有验证器都通过时值原样传递
+func (p *Pipe[In, Out]) Check(stage string, validators ...hvalid.ValidatorFunc[Out]) *Pipe[In, Out] {
+	return Then(p, stage, func(value Out) (Out, error) {
+		for _, validator := range validators {
+			if err := validator(value); err != nil {
+				return value, err
+			}
+		}
+		return value, nil
+	})
+}
+
+// Run 执行管道，返回最终值或指向失败阶段的 *StageError
+func (p *Pipe[In, Out]) Run(value In) (Out, error) {
+	return p.run(value)
+}
+
+// Validate 将管道转换为只关心是否通过的验证函数
+func (p *Pipe[In, Out]) Validate() hvalid.ValidatorFunc[In] {
+	return hvalid.ValidatorFunc[In](func(value In) error {
+		_, err := p.run(value)
+		return err
+	})
+}
+
+// Stages 获取所有阶段名称
+func (p *Pipe[In, Out]) Stages() []string {
+	return append([]string(nil), p.stages...)
+}
+
+// newStageError 创建阶段错误
+func newStageError(fieldName, stage string, err error) *StageError {
+	validationErr := hvalid.NewValidationError(fieldName)
+	validationErr.AddError(fmt.Sprintf("stage[%s]: %v", stage, err))
+	return &StageError{
+		Stage:      stage,
+		Err:        err,
+		validation: validationErr,
+	}
+}
+
+// appendStage 复制并追加阶段名称
+func appendStage(stages []string, names ...string) []string {
+	result := make([]string, 0, len(stages)+len(names))
+	result = append(result, stages...)
+	return append(result, names...)
+}
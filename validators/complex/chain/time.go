@@ -0,0 +1,59 @@
+package complex
+
+import (
+	"time"
+
+	"github.com/lyonnee/hvalid/validators/primitive"
+)
+
+// parseStage 时间解析管道的解析阶段名称
+const parseStage = "parse"
+
+// RFC3339Pipe 创建解析 RFC 3339 时间戳的管道，可以继续追加 TimeValidator 的规则：
+//
+//	tv := primitive.NewTimeValidator("start_at")
+//	pipe := complex.RFC3339Pipe("start_at").Check("range", tv.Between(open, close))
+//	startAt, err := pipe.Run("2025-10-19T08:30:00+08:00")
+func RFC3339Pipe(fieldName string) *Pipe[string, time.Time] {
+	return Parse(fieldName, parseStage, func(s string) (time.Time, error) {
+		return time.Parse(time.RFC3339, s)
+	})
+}
+
+// ISO8601DatePipe 创建解析 ISO 8601 日历日期、周日期或序数日期的管道，结果为 UTC 零点
+func ISO8601DatePipe(fieldName string) *Pipe[string, time.Time] {
+	return Parse(fieldName, parseStage, primitive.ParseISO8601Date)
+}
+
+// ISO8601Pipe 创建解析 ISO 8601 日期或日期时间的管道，没有时区时按 UTC 解析
+func ISO8601Pipe(fieldName string) *Pipe[string, time.Time] {
+	return Parse(fieldName, parseStage, primitive.ParseISO8601)
+}
+
+// TimeLayoutPipe 创建按自定义格式解析的管道，输入没有时区时使用 loc，loc 为 nil 时使用 UTC
+func TimeLayoutPipe(fieldName, layout string, loc *time.Location) *Pipe[string, time.Time] {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return Parse(fieldName, parseStage, func(s string) (time.Time, error) {
+		return time.ParseInLocation(layout, s, loc)
+	})
+}
+
+// UnixEpochPipe 创建解析 Unix 时间戳字符串的管道，unit 不合法时 panic，参见 primitive.ParseUnixEpoch
+func UnixEpochPipe(fieldName string, unit time.Duration) *Pipe[string, time.Time] {
+	primitive.MustEpochUnit(unit)
+	return Parse(fieldName, parseStage, func(s string) (time.Time, error) {
+		return primitive.ParseUnixEpoch(s, unit)
+	})
+}
+
+// DurationPipe 创建按 time.ParseDuration 解析时长的管道
+func DurationPipe(fieldName string) *Pipe[string, time.Duration] {
+	return Parse(fieldName, parseStage, time.ParseDuration)
+}
+
+// ISODurationPipe 创建解析 ISO 8601 时长的管道，例如 "P3DT4H"
+func ISODurationPipe(fieldName string) *Pipe[string, primitive.ISODuration] {
+	return Parse(fieldName, parseStage, primitive.ParseISODuration)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/lyonnee/hvalid"
+	"github.com/lyonnee/hvalid/validators/primitive"
 )
 
 // TransformValidator 转换验证器结构体
@@ -38,6 +39,8 @@ func (v *TransformValidator[T, U]) TransformWithError(transform func(T) (U, erro
 }
 
 // Map 对切片中的每个元素进行转换和验证
+//
+// validator 可以是另一个集合验证器，嵌套的错误路径会合并，例如 element[2][5]。
 func (v *TransformValidator[T, U]) Map(transform func(T) U, validator hvalid.ValidatorFunc[U]) hvalid.ValidatorFunc[[]T] {
 	return hvalid.ValidatorFunc[[]T](func(values []T) error {
 		validationErr := hvalid.NewValidationError(v.FieldName)
@@ -45,7 +48,7 @@ func (v *TransformValidator[T, U]) Map(transform func(T) U, validator hvalid.Val
 		for i, value := range values {
 			transformed := transform(value)
 			if err := validator(transformed); err != nil {
-				validationErr.AddError(fmt.Sprintf("element[%d]: %v", i, err))
+				primitive.AddPathError(validationErr, primitive.ElementPath(i), err)
 			}
 		}
 
@@ -56,15 +59,20 @@ func (v *TransformValidator[T, U]) Map(transform func(T) U, validator hvalid.Val
 	})
 }
 
-// Filter 过滤并验证元素
+// Filter 过滤并验证元素，与包级函数 Filter 相同，不使用类型参数 U
 func (v *TransformValidator[T, U]) Filter(filter func(T) bool, validator hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[[]T] {
+	return Filter(v.FieldName, filter, validator)
+}
+
+// Filter 只验证满足 filter 条件的元素，错误信息带有元素下标
+func Filter[T any](fieldName string, filter func(T) bool, validator hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[[]T] {
 	return hvalid.ValidatorFunc[[]T](func(values []T) error {
-		validationErr := hvalid.NewValidationError(v.FieldName)
+		validationErr := hvalid.NewValidationError(fieldName)
 
 		for i, value := range values {
 			if filter(value) {
 				if err := validator(value); err != nil {
-					validationErr.AddError(fmt.Sprintf("element[%d]: %v", i, err))
+					primitive.AddPathError(validationErr, primitive.ElementPath(i), err)
 				}
 			}
 		}
@@ -0,0 +1,258 @@
+package complex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// GraphRule 依赖图中的命名规则
+type GraphRule[T any] struct {
+	Name      string                  // 规则名称，在图中唯一
+	Validator hvalid.ValidatorFunc[T] // 验证器
+	DependsOn []string                // 前置规则名称
+}
+
+// RuleStatus 规则执行状态
+type RuleStatus int
+
+const (
+	// RulePassed 验证通过
+	RulePassed RuleStatus = iota
+	// RuleFailed 验证失败
+	RuleFailed
+	// RuleSkipped 前置规则未通过，跳过执行
+	RuleSkipped
+)
+
+// String 返回状态名称
+func (s RuleStatus) String() string {
+	switch s {
+	case RulePassed:
+		return "passed"
+	case RuleFailed:
+		return "failed"
+	case RuleSkipped:
+		return "skipped"
+	default:
+		return "unknown"
+	}
+}
+
+// RuleResult 单条规则的执行结果
+type RuleResult struct {
+	Name      string     // 规则名称
+	Status    RuleStatus // 执行状态
+	Err       error      // 失败时的错误
+	SkippedBy []string   // 导致跳过的前置规则
+}
+
+// GraphResult 依赖图的执行结果
+type GraphResult struct {
+	Rules []RuleResult // 按拓扑顺序排列的规则结果
+}
+
+// Get 获取指定规则的结果
+func (r GraphResult) Get(name string) (RuleResult, bool) {
+	for _, rule := range r.Rules {
+		if rule.Name == name {
+			return rule, true
+		}
+	}
+	return RuleResult{}, false
+}
+
+// Passed 是否所有规则都通过
+func (r GraphResult) Passed() bool {
+	for _, rule := range r.Rules {
+		if rule.Status != RulePassed {
+			return false
+		}
+	}
+	return true
+}
+
+// DependencyGraph 规则依赖图，构建时完成拓扑排序和环检测
+type DependencyGraph[T any] struct {
+	FieldName string // 字段名称
+	rules     []GraphRule[T]
+	order     []int // 拓扑顺序，元素为 rules 的下标
+	deps      [][]int
+}
+
+// Graph 使用命名规则构建依赖图，规则名称重复、依赖不存在或存在环时返回错误
+func (v *DependencyValidator[T]) Graph(rules ...GraphRule[T]) (*DependencyGraph[T], error) {
+	index := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("rule[%d] has no name", i)
+		}
+		if _, exists := index[rule.Name]; exists {
+			return nil, fmt.Errorf("duplicate rule name: %s", rule.Name)
+		}
+		if rule.Validator == nil {
+			return nil, fmt.Errorf("rule %s has no validator", rule.Name)
+		}
+		index[rule.Name] = i
+	}
+
+	deps := make([][]int, len(rules))
+	for i, rule := range rules {
+		for _, name := range rule.DependsOn {
+			dep, ok := index[name]
+			if !ok {
+				return nil, fmt.Errorf("rule %s depends on unknown rule: %s", rule.Name, name)
+			}
+			deps[i] = append(deps[i], dep)
+		}
+	}
+
+	order, err := topologicalOrder(rules, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DependencyGraph[T]{
+		FieldName: v.FieldName,
+		rules:     rules,
+		order:     order,
+		deps:      deps,
+	}, nil
+}
+
+// Run 执行依赖图，互不依赖的规则并行执行
+func (g *DependencyGraph[T]) Run(value T) GraphResult {
+	results := make([]RuleResult, len(g.rules))
+	done := make([]chan struct{}, len(g.rules))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	for i := range g.rules {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer close(done[i])
+
+			rule := g.rules[i]
+			result := RuleResult{Name: rule.Name}
+			// 先于 close(done[i]) 执行，规则调用 runtime.Goexit 时也能写入结果
+			defer func() { results[i] = result }()
+
+			for _, dep := range g.deps[i] {
+				<-done[dep]
+				if results[dep].Status != RulePassed {
+					result.SkippedBy = append(result.SkippedBy, g.rules[dep].Name)
+				}
+			}
+
+			if len(result.SkippedBy) > 0 {
+				result.Status = RuleSkipped
+				return
+			}
+
+			result.Status, result.Err = RuleFailed, errRuleExited
+			if err := runRule(rule.Validator, value); err != nil {
+				result.Err = err
+			} else {
+				result.Status, result.Err = RulePassed, nil
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	ordered := make([]RuleResult, len(g.order))
+	for i, idx := range g.order {
+		ordered[i] = results[idx]
+	}
+	return GraphResult{Rules: ordered}
+}
+
+// errRuleExited 规则调用 runtime.Goexit 时记录的错误
+var errRuleExited = errors.New("rule exited without returning")
+
+// runRule 执行单条规则，规则 panic 时视为失败，不影响其他规则和调用方
+func runRule[T any](validator hvalid.ValidatorFunc[T], value T) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("rule panicked: %v", r)
+		}
+	}()
+	return validator(value)
+}
+
+// Validate 执行依赖图，存在失败规则时返回验证错误，被跳过的规则一并列出
+func (g *DependencyGraph[T]) Validate() hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		validationErr := hvalid.NewValidationError(g.FieldName)
+
+		for _, rule := range g.Run(value).Rules {
+			switch rule.Status {
+			case RuleFailed:
+				validationErr.AddError(fmt.Sprintf("rule[%s] failed: %v", rule.Name, rule.Err))
+			case RuleSkipped:
+				validationErr.AddError(fmt.Sprintf("rule[%s] skipped: prerequisite %s not passed", rule.Name, strings.Join(rule.SkippedBy, ", ")))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// topologicalOrder 计算拓扑顺序，前置规则排在依赖它的规则之前，存在环时返回包含环路径的错误
+func topologicalOrder[T any](rules []GraphRule[T], deps [][]int) ([]int, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make([]int, len(rules))
+	order := make([]int, 0, len(rules))
+	var path []int
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			// 从路径中截取环
+			names := []string{rules[i].Name}
+			for j := len(path) - 1; j >= 0 && path[j] != i; j-- {
+				names = append(names, rules[path[j]].Name)
+			}
+			names = append(names, rules[i].Name)
+			for l, r := 0, len(names)-1; l < r; l, r = l+1, r-1 {
+				names[l], names[r] = names[r], names[l]
+			}
+			return fmt.Errorf("dependency cycle detected: %s", strings.Join(names, " -> "))
+		}
+
+		state[i] = visiting
+		path = append(path, i)
+		for _, dep := range deps[i] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[i] = visited
+		order = append(order, i)
+		return nil
+	}
+
+	for i := range rules {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
@@ -10,6 +10,13 @@ import (
 const (
 	ErrAllValidatorsFailed = "all validators failed"
 	ErrAnyValidatorFailed  = "any validator failed"
+	ErrShouldFail          = "validator[%d] should fail but passed"
+	ErrNotShouldFail       = "negated validator should fail but passed"
+	ErrExactlyOne          = "exactly one validator must pass, %d passed"
+	ErrAtLeastN            = "at least %d validators must pass, %d passed"
+	ErrAtMostN             = "at most %d validators may pass, %d passed"
+	ErrBranchPassed        = "validator[%d] passed"
+	ErrBranchFailed        = "validator[%d] failed: %v"
 )
 
 // LogicValidator 逻辑组合验证器结构体
@@ -64,9 +71,9 @@ func (v *LogicValidator[T]) None(validators ...hvalid.ValidatorFunc[T]) hvalid.V
 	return hvalid.ValidatorFunc[T](func(value T) error {
 		validationErr := hvalid.NewValidationError(v.FieldName)
 
-		for _, validator := range validators {
+		for i, validator := range validators {
 			if err := validator(value); err == nil {
-				validationErr.AddError(fmt.Sprintf("validator should fail: %v", validator))
+				validationErr.AddError(fmt.Sprintf(ErrShouldFail, i))
 			}
 		}
 
@@ -80,9 +87,67 @@ func (v *LogicValidator[T]) None(validators ...hvalid.ValidatorFunc[T]) hvalid.V
 // Not 验证器必须失败
 func (v *LogicValidator[T]) Not(validator hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
 	return hvalid.ValidatorFunc[T](func(value T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
 		if err := validator(value); err == nil {
-			return fmt.Errorf("validator should fail: %v", validator)
+			validationErr.AddError(ErrNotShouldFail)
+			return validationErr
 		}
 		return nil
 	})
 }
+
+// ExactlyOne 恰好一个验证器通过
+func (v *LogicValidator[T]) ExactlyOne(validators ...hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
+	return v.count(validators, func(passed int) bool { return passed == 1 }, func(passed int) string {
+		return fmt.Sprintf(ErrExactlyOne, passed)
+	})
+}
+
+// Xor 两个验证器中恰好一个通过
+func (v *LogicValidator[T]) Xor(a, b hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
+	return v.ExactlyOne(a, b)
+}
+
+// AtLeastN 至少 n 个验证器通过
+func (v *LogicValidator[T]) AtLeastN(n int, validators ...hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
+	return v.count(validators, func(passed int) bool { return passed >= n }, func(passed int) string {
+		return fmt.Sprintf(ErrAtLeastN, n, passed)
+	})
+}
+
+// AtMostN 至多 n 个验证器通过
+func (v *LogicValidator[T]) AtMostN(n int, validators ...hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
+	return v.count(validators, func(passed int) bool { return passed <= n }, func(passed int) string {
+		return fmt.Sprintf(ErrAtMostN, n, passed)
+	})
+}
+
+// count 统计通过的验证器数量，不满足 ok 时列出每个分支的结果
+func (v *LogicValidator[T]) count(validators []hvalid.ValidatorFunc[T], ok func(passed int) bool, summary func(passed int) string) hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		errs := make([]error, len(validators))
+		passed := 0
+
+		for i, validator := range validators {
+			if errs[i] = validator(value); errs[i] == nil {
+				passed++
+			}
+		}
+
+		if ok(passed) {
+			return nil
+		}
+
+		validationErr := hvalid.NewValidationError(v.FieldName)
+		validationErr.AddError(summary(passed))
+		for i, err := range errs {
+			if err == nil {
+				validationErr.AddError(fmt.Sprintf(ErrBranchPassed, i))
+			} else {
+				validationErr.AddError(fmt.Sprintf(ErrBranchFailed, i, err))
+			}
+		}
+		return validationErr
+	})
+}
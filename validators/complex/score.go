@@ -0,0 +1,171 @@
+package complex
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// Decision 评分决策
+type Decision int
+
+const (
+	// DecisionAccept 接受
+	DecisionAccept Decision = iota
+	// DecisionReview 人工复核
+	DecisionReview
+	// DecisionReject 拒绝
+	DecisionReject
+)
+
+// String 返回决策名称
+func (d Decision) String() string {
+	switch d {
+	case DecisionAccept:
+		return "accept"
+	case DecisionReview:
+		return "review"
+	case DecisionReject:
+		return "reject"
+	default:
+		return "unknown"
+	}
+}
+
+// ScoreRule 评分规则
+//
+// 内嵌的 Validator 不通过时视为规则命中，计入 Weight（可为负数）；
+// 通过时计入 PassWeight，可用于表示降低风险的信号。
+type ScoreRule[T any] struct {
+	WeightedValidator[T]
+	Reason     string  // 原因码
+	PassWeight float64 // 规则未命中时计入的分数
+}
+
+// ScoreBands 分数区间，总分低于 Review 时接受，低于 Reject 时复核，否则拒绝
+type ScoreBands struct {
+	Review float64 // 复核阈值
+	Reject float64 // 拒绝阈值，不能小于 Review
+}
+
+// Validate 检查分数区间是否有效
+func (b ScoreBands) Validate() error {
+	if math.IsNaN(b.Review) || math.IsNaN(b.Reject) {
+		return fmt.Errorf("score bands must not be NaN")
+	}
+	if b.Review > b.Reject {
+		return fmt.Errorf("review threshold %v is greater than reject threshold %v", b.Review, b.Reject)
+	}
+	return nil
+}
+
+// Decide 将总分映射为决策
+func (b ScoreBands) Decide(total float64) Decision {
+	switch {
+	case total >= b.Reject:
+		return DecisionReject
+	case total >= b.Review:
+		return DecisionReview
+	default:
+		return DecisionAccept
+	}
+}
+
+// ScoreEntry 单条规则的评分明细
+type ScoreEntry struct {
+	Index     int     // 规则序号
+	Reason    string  // 原因码
+	Triggered bool    // 规则是否命中
+	Score     float64 // 计入的分数
+	Err       error   // 命中时验证器返回的错误
+}
+
+// ScoreResult 评分结果
+type ScoreResult struct {
+	Total     float64      // 总分
+	Decision  Decision     // 决策
+	Breakdown []ScoreEntry // 每条规则的明细，顺序与规则一致
+}
+
+// Triggered 返回命中的规则明细
+func (r ScoreResult) Triggered() []ScoreEntry {
+	entries := make([]ScoreEntry, 0, len(r.Breakdown))
+	for _, entry := range r.Breakdown {
+		if entry.Triggered {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// ScoreError 评分未通过时返回的错误，携带完整的评分结果
+type ScoreError struct {
+	Result ScoreResult
+	err    *hvalid.ValidationError
+}
+
+// Error 实现 error 接口
+func (e *ScoreError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap 返回验证错误
+func (e *ScoreError) Unwrap() error {
+	return e.err
+}
+
+// RiskScorer 风险评分器
+type RiskScorer[T any] struct {
+	FieldName string // 字段名称
+	rules     []ScoreRule[T]
+	bands     ScoreBands
+}
+
+// Scorer 使用评分规则和分数区间创建风险评分器，分数区间无效时 panic
+func (v *AggregateValidator[T]) Scorer(rules []ScoreRule[T], bands ScoreBands) *RiskScorer[T] {
+	if err := bands.Validate(); err != nil {
+		panic("complex: invalid score bands: " + err.Error())
+	}
+	return &RiskScorer[T]{
+		FieldName: v.FieldName,
+		rules:     rules,
+		bands:     bands,
+	}
+}
+
+// Evaluate 执行所有规则并返回评分结果
+func (s *RiskScorer[T]) Evaluate(value T) ScoreResult {
+	result := ScoreResult{Breakdown: make([]ScoreEntry, len(s.rules))}
+
+	for i, rule := range s.rules {
+		entry := ScoreEntry{Index: i, Reason: rule.Reason, Score: rule.PassWeight}
+		if err := rule.Validator(value); err != nil {
+			entry.Triggered = true
+			entry.Score = rule.Weight
+			entry.Err = err
+		}
+		result.Total += entry.Score
+		result.Breakdown[i] = entry
+	}
+
+	result.Decision = s.bands.Decide(result.Total)
+	return result
+}
+
+// Validate 决策达到 failAt 时验证失败，返回的 *ScoreError 携带完整评分结果
+func (s *RiskScorer[T]) Validate(failAt Decision) hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		result := s.Evaluate(value)
+		if result.Decision < failAt {
+			return nil
+		}
+
+		validationErr := hvalid.NewValidationError(s.FieldName)
+		validationErr.AddError(fmt.Sprintf("risk score %.2f: %s", result.Total, result.Decision))
+		for _, entry := range result.Triggered() {
+			validationErr.AddError(fmt.Sprintf("%s(%+.2f): %v", entry.Reason, entry.Score, entry.Err))
+		}
+		return &ScoreError{Result: result, err: validationErr}
+	})
+}
@@ -0,0 +1,136 @@
+package complex
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// SingleflightValidator 请求合并验证器结构体
+//
+// 并发验证相同键的值时只执行一次验证，其余调用等待并共享结果。
+// 与 CacheValidator 组合时应放在缓存内侧：
+//
+//	cache.WithCache(singleflight.WithSingleflight(validator))
+//
+// 这样缓存未命中的并发请求只会触发一次后端调用。
+type SingleflightValidator[T any] struct {
+	FieldName string     // 字段名称
+	KeyFunc   KeyFunc[T] // 合并键生成函数，为空时使用与 CacheValidator 相同的默认策略
+
+	mu         sync.Mutex
+	calls      map[string]*flightCall
+	wraps      atomic.Uint64 // 已包装的验证器数量，用于区分各自的合并键
+	executions atomic.Int64
+	coalesced  atomic.Int64
+}
+
+// flightCall 正在执行的验证
+type flightCall struct {
+	done chan struct{}
+	err  error
+}
+
+// SingleflightStats 请求合并统计信息
+type SingleflightStats struct {
+	Executions int64 // 实际执行验证的次数
+	Coalesced  int64 // 被合并、共享他人结果的调用次数
+	InFlight   int   // 当前正在执行的验证数
+}
+
+// NewSingleflightValidator 创建请求合并验证器
+func NewSingleflightValidator[T any](fieldName string) *SingleflightValidator[T] {
+	return &SingleflightValidator[T]{
+		FieldName: fieldName,
+		calls:     make(map[string]*flightCall),
+	}
+}
+
+// WithSingleflight 合并并发的相同验证
+//
+// 每次调用返回的验证函数使用独立的合并键空间，不同验证器验证相同的值时不会共享结果。
+func (v *SingleflightValidator[T]) WithSingleflight(validator hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[T] {
+	prefix := fmt.Sprintf("%d/", v.wraps.Add(1))
+
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		key, keyErr := valueKey(v.KeyFunc, value, false)
+		if keyErr != nil {
+			// 无法生成合并键时直接执行验证
+			v.executions.Add(1)
+			return validator(value)
+		}
+		key = prefix + key
+
+		v.mu.Lock()
+		if v.calls == nil {
+			v.calls = make(map[string]*flightCall)
+		}
+		if call, ok := v.calls[key]; ok {
+			v.mu.Unlock()
+
+			v.coalesced.Add(1)
+			<-call.done
+			return call.err
+		}
+
+		call := &flightCall{done: make(chan struct{})}
+		v.calls[key] = call
+		v.mu.Unlock()
+
+		v.executions.Add(1)
+		v.execute(key, call, validator, value)
+		return call.err
+	})
+}
+
+// Stats 获取请求合并统计信息
+func (v *SingleflightValidator[T]) Stats() SingleflightStats {
+	v.mu.Lock()
+	inFlight := len(v.calls)
+	v.mu.Unlock()
+
+	return SingleflightStats{
+		Executions: v.executions.Load(),
+		Coalesced:  v.coalesced.Load(),
+		InFlight:   inFlight,
+	}
+}
+
+// ResetStats 重置统计计数
+func (v *SingleflightValidator[T]) ResetStats() {
+	v.executions.Store(0)
+	v.coalesced.Store(0)
+}
+
+// execute 执行验证并唤醒所有等待者
+//
+// 验证器 panic 时，等待者收到错误，执行者以原始值重新 panic；
+// 验证器调用 runtime.Goexit 时，等待者同样收到错误，执行者继续退出。
+func (v *SingleflightValidator[T]) execute(key string, call *flightCall, validator hvalid.ValidatorFunc[T], value T) {
+	normalReturn := false
+	defer func() {
+		var r any
+		if !normalReturn {
+			if r = recover(); r != nil {
+				call.err = fmt.Errorf("validation panicked: %v", r)
+			} else {
+				call.err = errors.New("validation exited without returning")
+			}
+		}
+
+		v.mu.Lock()
+		delete(v.calls, key)
+		v.mu.Unlock()
+		close(call.done)
+
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	call.err = validator(value)
+	normalReturn = true
+}
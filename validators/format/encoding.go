@@ -0,0 +1,88 @@
+package format
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrNotHex       = "must be a valid hex string"
+	ErrNotBase64    = "must be valid base64"
+	ErrNotBase64URL = "must be valid base64url"
+	ErrNotBase32    = "must be valid base32"
+	ErrNotJSON      = "must be valid JSON"
+)
+
+// Hex 验证偶数长度的十六进制字符串，不区分大小写
+func (v *FormatValidator) Hex() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotHex, func(s string) error {
+		if err := checkEncoded(s); err != nil {
+			return err
+		}
+		_, err := hex.DecodeString(s)
+		return err
+	})
+}
+
+// Base64 验证标准 base64 编码（RFC 4648 第 4 节），必须带填充，且未使用的比特位为 0
+func (v *FormatValidator) Base64() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotBase64, func(s string) error {
+		if err := checkEncoded(s); err != nil {
+			return err
+		}
+		_, err := base64.StdEncoding.Strict().DecodeString(s)
+		return err
+	})
+}
+
+// Base64URL 验证 URL 安全的 base64 编码（RFC 4648 第 5 节），填充可有可无
+func (v *FormatValidator) Base64URL() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotBase64URL, func(s string) error {
+		if err := checkEncoded(s); err != nil {
+			return err
+		}
+		encoding := base64.RawURLEncoding
+		if strings.HasSuffix(s, "=") {
+			encoding = base64.URLEncoding
+		}
+		_, err := encoding.Strict().DecodeString(s)
+		return err
+	})
+}
+
+// Base32 验证标准 base32 编码（RFC 4648 第 6 节），必须带填充
+func (v *FormatValidator) Base32() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotBase32, func(s string) error {
+		if err := checkEncoded(s); err != nil {
+			return err
+		}
+		_, err := base32.StdEncoding.DecodeString(s)
+		return err
+	})
+}
+
+// JSON 验证合法的 JSON 文本
+func (v *FormatValidator) JSON() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotJSON, func(s string) error {
+		var raw json.RawMessage
+		return json.Unmarshal([]byte(s), &raw)
+	})
+}
+
+// checkEncoded 拒绝空字符串和换行符，标准库解码时会跳过 \r 和 \n
+func checkEncoded(s string) error {
+	if s == "" {
+		return errors.New("empty string")
+	}
+	if strings.ContainsAny(s, "\r\n") {
+		return errors.New("line breaks are not allowed")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package format
+
+import (
+	"fmt"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// FormatValidator 机器可读格式验证器结构体
+type FormatValidator struct {
+	FieldName string // 字段名称
+}
+
+// NewFormatValidator 创建格式验证器
+func NewFormatValidator(fieldName string) *FormatValidator {
+	return &FormatValidator{
+		FieldName: fieldName,
+	}
+}
+
+// rule 将检查函数包装为验证函数，检查函数返回的错误作为失败原因附加在错误信息之后
+func (v *FormatValidator) rule(message string, check func(string) error) hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if err := check(field); err != nil {
+			validationErr.AddError(fmt.Sprintf("%s: %v", message, err))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// isDigit 是否为 ASCII 数字
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isAlpha 是否为 ASCII 字母
+func isAlpha(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// isAlnum 是否为 ASCII 字母或数字
+func isAlnum(c byte) bool {
+	return isDigit(c) || isAlpha(c)
+}
+
+// allBytes 字符串是否非空且每个字节都满足条件
+func allBytes(s string, ok func(byte) bool) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !ok(s[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrNotUUID  = "must be a valid UUID"
+	ErrNotULID  = "must be a valid ULID"
+	ErrNotKSUID = "must be a valid KSUID"
+)
+
+// crockfordAlphabet ULID 使用的 Crockford Base32 字母表
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// maxKSUID 最大的 KSUID（20 字节全为 0xff 的 base62 表示）
+const maxKSUID = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// UUID 验证 8-4-4-4-12 格式的 UUID，不区分大小写
+//
+// 指定 versions 时，版本号必须是其中之一，且变体必须为 RFC 4122（RFC 9562）变体。
+func (v *FormatValidator) UUID(versions ...int) hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotUUID, func(s string) error {
+		return checkUUID(s, versions)
+	})
+}
+
+// ULID 验证 26 位 Crockford Base32 编码的 ULID，不区分大小写
+func (v *FormatValidator) ULID() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotULID, checkULID)
+}
+
+// KSUID 验证 27 位 base62 编码的 KSUID
+func (v *FormatValidator) KSUID() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotKSUID, checkKSUID)
+}
+
+// checkUUID 检查 UUID
+func checkUUID(s string, versions []int) error {
+	if len(s) != 36 {
+		return fmt.Errorf("length must be 36, got %d", len(s))
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return fmt.Errorf("expected '-' at position %d", i)
+			}
+		default:
+			if !isHexDigit(s[i]) {
+				return fmt.Errorf("invalid character %q at position %d", s[i], i)
+			}
+		}
+	}
+
+	if len(versions) == 0 {
+		return nil
+	}
+
+	version := hexValue(s[14])
+	allowed := false
+	for _, v := range versions {
+		if int(version) == v {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return fmt.Errorf("version %d is not allowed", version)
+	}
+	if variant := hexValue(s[19]); variant&0xc != 0x8 {
+		return errors.New("variant must be RFC 4122")
+	}
+	return nil
+}
+
+// checkULID 检查 ULID
+func checkULID(s string) error {
+	if len(s) != 26 {
+		return fmt.Errorf("length must be 26, got %d", len(s))
+	}
+	upper := strings.ToUpper(s)
+	for i := 0; i < len(upper); i++ {
+		if strings.IndexByte(crockfordAlphabet, upper[i]) < 0 {
+			return fmt.Errorf("invalid character %q at position %d", s[i], i)
+		}
+	}
+	// 128 位时间戳加随机数的最高位只能是 0-7
+	if upper[0] > '7' {
+		return errors.New("timestamp overflow")
+	}
+	return nil
+}
+
+// checkKSUID 检查 KSUID
+func checkKSUID(s string) error {
+	if len(s) != len(maxKSUID) {
+		return fmt.Errorf("length must be %d, got %d", len(maxKSUID), len(s))
+	}
+	for i := 0; i < len(s); i++ {
+		if !isAlnum(s[i]) {
+			return fmt.Errorf("invalid character %q at position %d", s[i], i)
+		}
+	}
+	// base62 字母表顺序与 ASCII 顺序一致，等长时可以直接比较
+	if s > maxKSUID {
+		return errors.New("value out of range")
+	}
+	return nil
+}
+
+// isHexDigit 是否为十六进制数字
+func isHexDigit(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// hexValue 十六进制数字的值
+func hexValue(c byte) byte {
+	switch {
+	case isDigit(c):
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
@@ -0,0 +1,79 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrNotMAC      = "must be a valid MAC address"
+	ErrNotHostname = "must be a valid hostname"
+	ErrNotFQDN     = "must be a fully qualified domain name"
+)
+
+// MAC 验证 MAC 地址，支持 EUI-48、EUI-64 和 20 字节 InfiniBand 地址，以及冒号、连字符和点号分隔格式
+func (v *FormatValidator) MAC() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotMAC, func(s string) error {
+		_, err := net.ParseMAC(s)
+		return err
+	})
+}
+
+// Hostname 验证 RFC 1123 主机名，允许以点号结尾
+func (v *FormatValidator) Hostname() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotHostname, func(s string) error {
+		_, err := hostnameLabels(s)
+		return err
+	})
+}
+
+// FQDN 验证完全限定域名：至少包含两个标签，且顶级域名不能全部为数字
+func (v *FormatValidator) FQDN() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotFQDN, func(s string) error {
+		labels, err := hostnameLabels(s)
+		if err != nil {
+			return err
+		}
+		if len(labels) < 2 {
+			return errors.New("must contain at least two labels")
+		}
+		if tld := labels[len(labels)-1]; allBytes(tld, isDigit) {
+			return fmt.Errorf("top-level domain %q must not be numeric", tld)
+		}
+		return nil
+	})
+}
+
+// hostnameLabels 按 RFC 1123 解析主机名的标签
+func hostnameLabels(s string) ([]string, error) {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" {
+		return nil, errors.New("empty hostname")
+	}
+	if len(s) > 253 {
+		return nil, fmt.Errorf("length must be at most 253, got %d", len(s))
+	}
+
+	labels := strings.Split(s, ".")
+	for _, label := range labels {
+		switch {
+		case label == "":
+			return nil, errors.New("empty label")
+		case len(label) > 63:
+			return nil, fmt.Errorf("label %q longer than 63 characters", label)
+		case label[0] == '-' || label[len(label)-1] == '-':
+			return nil, fmt.Errorf("label %q must not start or end with '-'", label)
+		}
+		for i := 0; i < len(label); i++ {
+			if !isAlnum(label[i]) && label[i] != '-' {
+				return nil, fmt.Errorf("invalid character %q in label %q", label[i], label)
+			}
+		}
+	}
+	return labels, nil
+}
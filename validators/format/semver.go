@@ -0,0 +1,78 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrNotSemVer = "must be a valid semantic version"
+)
+
+// SemVer 验证 Semantic Versioning 2.0.0 版本号，例如 1.2.3-rc.1+build.5，不接受 v 前缀
+func (v *FormatValidator) SemVer() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotSemVer, checkSemVer)
+}
+
+// checkSemVer 检查语义化版本号
+func checkSemVer(s string) error {
+	version, build, hasBuild := strings.Cut(s, "+")
+	if hasBuild {
+		if err := checkIdentifiers(build, "build metadata", false); err != nil {
+			return err
+		}
+	}
+
+	core, prerelease, hasPrerelease := strings.Cut(version, "-")
+	if hasPrerelease {
+		if err := checkIdentifiers(prerelease, "pre-release", true); err != nil {
+			return err
+		}
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("version core %q must be MAJOR.MINOR.PATCH", core)
+	}
+	for _, part := range parts {
+		if err := checkNumericIdentifier(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkIdentifiers 检查点号分隔的标识符，numeric 为 true 时纯数字标识符不能有前导零
+func checkIdentifiers(s, kind string, numeric bool) error {
+	for _, identifier := range strings.Split(s, ".") {
+		if identifier == "" {
+			return fmt.Errorf("empty %s identifier", kind)
+		}
+		for i := 0; i < len(identifier); i++ {
+			if !isAlnum(identifier[i]) && identifier[i] != '-' {
+				return fmt.Errorf("invalid character %q in %s identifier %q", identifier[i], kind, identifier)
+			}
+		}
+		if numeric && allBytes(identifier, isDigit) {
+			if err := checkNumericIdentifier(identifier); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkNumericIdentifier 检查数字标识符
+func checkNumericIdentifier(s string) error {
+	if !allBytes(s, isDigit) {
+		return fmt.Errorf("%q is not a number", s)
+	}
+	if len(s) > 1 && s[0] == '0' {
+		return errors.New("numeric identifiers must not have leading zeros")
+	}
+	return nil
+}
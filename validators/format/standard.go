@@ -0,0 +1,169 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrNotCurrency    = "must be a valid ISO 4217 currency code"
+	ErrNotCountry     = "must be a valid ISO 3166-1 country code"
+	ErrNotLanguageTag = "must be a valid BCP 47 language tag"
+	ErrNotTimezone    = "must be a valid IANA time zone name"
+)
+
+// irregularLanguageTags RFC 5646 中不符合常规语法的祖传标签
+var irregularLanguageTags = map[string]struct{}{
+	"en-gb-oed": {}, "i-ami": {}, "i-bnn": {}, "i-default": {}, "i-enochian": {}, "i-hak": {},
+	"i-klingon": {}, "i-lux": {}, "i-mingo": {}, "i-navajo": {}, "i-pwn": {}, "i-tao": {},
+	"i-tay": {}, "i-tsu": {}, "sgn-be-fr": {}, "sgn-be-nl": {}, "sgn-ch-de": {},
+}
+
+// Currency 验证 ISO 4217 现行货币字母代码，必须大写，例如 USD、CNY
+func (v *FormatValidator) Currency() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotCurrency, func(s string) error {
+		if _, ok := currencies[s]; !ok {
+			return fmt.Errorf("unknown code %q", s)
+		}
+		return nil
+	})
+}
+
+// CountryCode 验证 ISO 3166-1 二位字母国家代码，必须大写，例如 CN、US
+func (v *FormatValidator) CountryCode() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotCountry, func(s string) error {
+		if _, ok := countries[s]; !ok {
+			return fmt.Errorf("unknown alpha-2 code %q", s)
+		}
+		return nil
+	})
+}
+
+// CountryCodeAlpha3 验证 ISO 3166-1 三位字母国家代码，必须大写，例如 CHN、USA
+func (v *FormatValidator) CountryCodeAlpha3() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotCountry, func(s string) error {
+		if _, ok := countryAlpha3[s]; !ok {
+			return fmt.Errorf("unknown alpha-3 code %q", s)
+		}
+		return nil
+	})
+}
+
+// LanguageTag 验证 BCP 47（RFC 5646）语言标签的语法，例如 zh-Hans-CN、en-US、sr-Latn-RS，不区分大小写
+//
+// 只检查标签是否符合语法（well-formed），不检查子标签是否已在 IANA 注册表中登记。
+func (v *FormatValidator) LanguageTag() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotLanguageTag, checkLanguageTag)
+}
+
+// Timezone 验证 IANA 时区名称，例如 Asia/Shanghai、UTC
+//
+// 使用 time.LoadLocation 加载，依赖系统时区数据库；运行环境没有时区数据时，需要导入 time/tzdata。
+func (v *FormatValidator) Timezone() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotTimezone, func(s string) error {
+		if s == "" || s == "Local" {
+			return fmt.Errorf("%q is not a time zone name", s)
+		}
+		_, err := time.LoadLocation(s)
+		return err
+	})
+}
+
+// checkLanguageTag 检查语言标签语法
+func checkLanguageTag(s string) error {
+	tag := strings.ToLower(s)
+	if _, ok := irregularLanguageTags[tag]; ok {
+		return nil
+	}
+
+	subtags := strings.Split(tag, "-")
+	for _, subtag := range subtags {
+		if subtag == "" || len(subtag) > 8 || !allBytes(subtag, isAlnum) {
+			return fmt.Errorf("invalid subtag %q", subtag)
+		}
+	}
+
+	i := 0
+	next := func(ok func(string) bool) bool {
+		if i < len(subtags) && ok(subtags[i]) {
+			i++
+			return true
+		}
+		return false
+	}
+
+	// 以 x 开头时整个标签都是私有子标签
+	if subtags[0] != "x" {
+		// language = 2*3ALPHA ["-" extlang] / 4ALPHA / 5*8ALPHA
+		if !next(func(s string) bool { return len(s) >= 2 && allBytes(s, isAlpha) }) {
+			return fmt.Errorf("invalid primary language subtag %q", subtags[0])
+		}
+		if len(subtags[0]) <= 3 {
+			// extlang = 3ALPHA *2("-" 3ALPHA)
+			for n := 0; n < 3; n++ {
+				if !next(func(s string) bool { return len(s) == 3 && allBytes(s, isAlpha) }) {
+					break
+				}
+			}
+		}
+
+		// script = 4ALPHA
+		next(func(s string) bool { return len(s) == 4 && allBytes(s, isAlpha) })
+
+		// region = 2ALPHA / 3DIGIT
+		next(func(s string) bool {
+			return len(s) == 2 && allBytes(s, isAlpha) || len(s) == 3 && allBytes(s, isDigit)
+		})
+
+		// variant = 5*8alphanum / (DIGIT 3alphanum)
+		variants := make(map[string]struct{})
+		for i < len(subtags) {
+			variant := subtags[i]
+			if !(len(variant) >= 5 || len(variant) == 4 && isDigit(variant[0])) {
+				break
+			}
+			if _, dup := variants[variant]; dup {
+				return fmt.Errorf("duplicate variant %q", variant)
+			}
+			variants[variant] = struct{}{}
+			i++
+		}
+
+		// extension = singleton 1*("-" (2*8alphanum))
+		singletons := make(map[string]struct{})
+		for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+			singleton := subtags[i]
+			if _, dup := singletons[singleton]; dup {
+				return fmt.Errorf("duplicate extension %q", singleton)
+			}
+			singletons[singleton] = struct{}{}
+			i++
+
+			count := 0
+			for next(func(s string) bool { return len(s) >= 2 }) {
+				count++
+			}
+			if count == 0 {
+				return fmt.Errorf("extension %q has no subtags", singleton)
+			}
+		}
+	}
+
+	// privateuse = "x" 1*("-" (1*8alphanum))
+	if i < len(subtags) && subtags[i] == "x" {
+		if i == len(subtags)-1 {
+			return errors.New("private use section has no subtags")
+		}
+		return nil
+	}
+
+	if i < len(subtags) {
+		return fmt.Errorf("unexpected subtag %q", subtags[i])
+	}
+	return nil
+}
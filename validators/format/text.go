@@ -0,0 +1,48 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrNotSlug     = "must be a valid slug"
+	ErrNotMIMEType = "must be a valid MIME type"
+)
+
+// Slug 验证由小写字母和数字组成、以单个连字符分隔的 slug，例如 hello-world-2
+func (v *FormatValidator) Slug() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotSlug, func(s string) error {
+		for _, segment := range strings.Split(s, "-") {
+			if segment == "" {
+				return errors.New("must not be empty or contain leading, trailing or consecutive '-'")
+			}
+			for i := 0; i < len(segment); i++ {
+				if c := segment[i]; !isDigit(c) && (c < 'a' || c > 'z') {
+					return fmt.Errorf("invalid character %q", c)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// MIMEType 验证 RFC 2045 媒体类型，例如 text/plain; charset=utf-8，类型和子类型都必须存在
+func (v *FormatValidator) MIMEType() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrNotMIMEType, func(s string) error {
+		mediaType, _, err := mime.ParseMediaType(s)
+		if err != nil {
+			return err
+		}
+		typ, subtype, ok := strings.Cut(mediaType, "/")
+		if !ok || typ == "" || subtype == "" {
+			return fmt.Errorf("%q must be in type/subtype form", mediaType)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,323 @@
+package primitive
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrBigNil          = "must not be nil"
+	ErrBigNegative     = "must not be negative"
+	ErrBigNotPositive  = "must be positive"
+	ErrBigInfinite     = "must be finite"
+	ErrBigTooManyDigit = "must have at most %d digits"
+	ErrBigScale        = "must have at most %d decimal places"
+	ErrBigPrecision    = "must fit DECIMAL(%d,%d)"
+)
+
+// BigIntValidator *big.Int 验证器结构体
+type BigIntValidator struct {
+	FieldName string // 字段名称
+}
+
+// NewBigIntValidator 创建 *big.Int 验证器
+func NewBigIntValidator(fieldName string) *BigIntValidator {
+	return &BigIntValidator{
+		FieldName: fieldName,
+	}
+}
+
+// Min 验证最小值，min 为 nil 时 panic
+func (v *BigIntValidator) Min(min *big.Int) hvalid.ValidatorFunc[*big.Int] {
+	bound := new(big.Int).Set(mustBound(min))
+	return bigRule(v.FieldName, func(n *big.Int) string {
+		if n.Cmp(bound) < 0 {
+			return fmt.Sprintf(ErrNumberTooSmall, bound)
+		}
+		return ""
+	})
+}
+
+// Max 验证最大值，max 为 nil 时 panic
+func (v *BigIntValidator) Max(max *big.Int) hvalid.ValidatorFunc[*big.Int] {
+	bound := new(big.Int).Set(mustBound(max))
+	return bigRule(v.FieldName, func(n *big.Int) string {
+		if n.Cmp(bound) > 0 {
+			return fmt.Sprintf(ErrNumberTooBig, bound)
+		}
+		return ""
+	})
+}
+
+// NonNegative 验证不是负数
+func (v *BigIntValidator) NonNegative() hvalid.ValidatorFunc[*big.Int] {
+	return bigRule(v.FieldName, func(n *big.Int) string {
+		if n.Sign() < 0 {
+			return ErrBigNegative
+		}
+		return ""
+	})
+}
+
+// Positive 验证是正数
+func (v *BigIntValidator) Positive() hvalid.ValidatorFunc[*big.Int] {
+	return bigRule(v.FieldName, func(n *big.Int) string {
+		if n.Sign() <= 0 {
+			return ErrBigNotPositive
+		}
+		return ""
+	})
+}
+
+// MaxDigits 验证十进制位数（不含符号）不超过 digits，相当于 DECIMAL(digits,0)
+func (v *BigIntValidator) MaxDigits(digits int) hvalid.ValidatorFunc[*big.Int] {
+	return bigRule(v.FieldName, func(n *big.Int) string {
+		if integerDigits(n) > digits {
+			return fmt.Sprintf(ErrBigTooManyDigit, digits)
+		}
+		return ""
+	})
+}
+
+// BigRatValidator *big.Rat 验证器结构体
+type BigRatValidator struct {
+	FieldName string // 字段名称
+}
+
+// NewBigRatValidator 创建 *big.Rat 验证器
+func NewBigRatValidator(fieldName string) *BigRatValidator {
+	return &BigRatValidator{
+		FieldName: fieldName,
+	}
+}
+
+// Min 验证最小值，min 为 nil 时 panic
+func (v *BigRatValidator) Min(min *big.Rat) hvalid.ValidatorFunc[*big.Rat] {
+	return bigRule(v.FieldName, ratMin(new(big.Rat).Set(mustBound(min))))
+}
+
+// Max 验证最大值，max 为 nil 时 panic
+func (v *BigRatValidator) Max(max *big.Rat) hvalid.ValidatorFunc[*big.Rat] {
+	return bigRule(v.FieldName, ratMax(new(big.Rat).Set(mustBound(max))))
+}
+
+// NonNegative 验证不是负数
+func (v *BigRatValidator) NonNegative() hvalid.ValidatorFunc[*big.Rat] {
+	return bigRule(v.FieldName, ratNonNegative)
+}
+
+// MaxScale 验证可以精确表示为最多 scale 位小数的十进制数，例如 1/4 为 2 位、1/3 无法表示
+func (v *BigRatValidator) MaxScale(scale int) hvalid.ValidatorFunc[*big.Rat] {
+	return bigRule(v.FieldName, ratScale(scale))
+}
+
+// Precision 验证可以精确存入 SQL DECIMAL(precision,scale)，即小数不超过 scale 位、整数部分不超过 precision-scale 位；
+// 参数无效时 panic
+func (v *BigRatValidator) Precision(precision, scale int) hvalid.ValidatorFunc[*big.Rat] {
+	return bigRule(v.FieldName, ratPrecision(precision, scale))
+}
+
+// BigFloatValidator *big.Float 验证器结构体
+type BigFloatValidator struct {
+	FieldName string // 字段名称
+}
+
+// NewBigFloatValidator 创建 *big.Float 验证器
+func NewBigFloatValidator(fieldName string) *BigFloatValidator {
+	return &BigFloatValidator{
+		FieldName: fieldName,
+	}
+}
+
+// Min 验证最小值，min 为 nil 时 panic
+func (v *BigFloatValidator) Min(min *big.Float) hvalid.ValidatorFunc[*big.Float] {
+	bound := new(big.Float).Copy(mustBound(min))
+	return bigRule(v.FieldName, func(f *big.Float) string {
+		if f.Cmp(bound) < 0 {
+			return fmt.Sprintf(ErrNumberTooSmall, bound.Text('g', -1))
+		}
+		return ""
+	})
+}
+
+// Max 验证最大值，max 为 nil 时 panic
+func (v *BigFloatValidator) Max(max *big.Float) hvalid.ValidatorFunc[*big.Float] {
+	bound := new(big.Float).Copy(mustBound(max))
+	return bigRule(v.FieldName, func(f *big.Float) string {
+		if f.Cmp(bound) > 0 {
+			return fmt.Sprintf(ErrNumberTooBig, bound.Text('g', -1))
+		}
+		return ""
+	})
+}
+
+// NonNegative 验证不是负数，-0 视为非负
+func (v *BigFloatValidator) NonNegative() hvalid.ValidatorFunc[*big.Float] {
+	return bigRule(v.FieldName, func(f *big.Float) string {
+		if f.Sign() < 0 {
+			return ErrBigNegative
+		}
+		return ""
+	})
+}
+
+// Finite 验证不是无穷大
+func (v *BigFloatValidator) Finite() hvalid.ValidatorFunc[*big.Float] {
+	return bigRule(v.FieldName, func(f *big.Float) string {
+		if f.IsInf() {
+			return ErrBigInfinite
+		}
+		return ""
+	})
+}
+
+// MaxScale 验证二进制浮点值可以精确表示为最多 scale 位小数，例如 0.5 通过 MaxScale(1)，
+// 而 SetString("0.1") 得到的近似值不能精确表示为有限位小数
+func (v *BigFloatValidator) MaxScale(scale int) hvalid.ValidatorFunc[*big.Float] {
+	return bigRule(v.FieldName, floatRule(ratScale(scale)))
+}
+
+// Precision 验证可以精确存入 SQL DECIMAL(precision,scale)，参数无效时 panic
+func (v *BigFloatValidator) Precision(precision, scale int) hvalid.ValidatorFunc[*big.Float] {
+	return bigRule(v.FieldName, floatRule(ratPrecision(precision, scale)))
+}
+
+// bigRule 将检查函数包装为验证函数，检查函数返回空字符串表示通过，值为 nil 时验证失败
+func bigRule[T interface{ *big.Int | *big.Rat | *big.Float }](fieldName string, check func(T) string) hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		validationErr := hvalid.NewValidationError(fieldName)
+
+		if value == nil {
+			validationErr.AddError(ErrBigNil)
+			return validationErr
+		}
+		if message := check(value); message != "" {
+			validationErr.AddError(message)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// floatRule 将 *big.Rat 的检查函数用于 *big.Float，无穷大视为不通过
+func floatRule(check func(*big.Rat) string) func(*big.Float) string {
+	return func(f *big.Float) string {
+		if f.IsInf() {
+			return ErrBigInfinite
+		}
+		r, _ := f.Rat(nil)
+		return check(r)
+	}
+}
+
+// ratMin 最小值检查
+func ratMin(bound *big.Rat) func(*big.Rat) string {
+	return func(r *big.Rat) string {
+		if r.Cmp(bound) < 0 {
+			return fmt.Sprintf(ErrNumberTooSmall, formatRat(bound))
+		}
+		return ""
+	}
+}
+
+// ratMax 最大值检查
+func ratMax(bound *big.Rat) func(*big.Rat) string {
+	return func(r *big.Rat) string {
+		if r.Cmp(bound) > 0 {
+			return fmt.Sprintf(ErrNumberTooBig, formatRat(bound))
+		}
+		return ""
+	}
+}
+
+// ratNonNegative 非负检查
+func ratNonNegative(r *big.Rat) string {
+	if r.Sign() < 0 {
+		return ErrBigNegative
+	}
+	return ""
+}
+
+// ratScale 小数位数检查
+func ratScale(scale int) func(*big.Rat) string {
+	return func(r *big.Rat) string {
+		if _, ok := DecimalScaleWithin(r, scale); !ok {
+			return fmt.Sprintf(ErrBigScale, scale)
+		}
+		return ""
+	}
+}
+
+// ratPrecision DECIMAL(precision,scale) 检查，参数无效时 panic
+func ratPrecision(precision, scale int) func(*big.Rat) string {
+	if precision <= 0 || scale < 0 || scale > precision {
+		panic(fmt.Sprintf("primitive: invalid DECIMAL(%d,%d)", precision, scale))
+	}
+	return func(r *big.Rat) string {
+		_, ok := DecimalScaleWithin(r, scale)
+		if !ok || integerDigits(new(big.Int).Quo(r.Num(), r.Denom())) > precision-scale {
+			return fmt.Sprintf(ErrBigPrecision, precision, scale)
+		}
+		return ""
+	}
+}
+
+// DecimalScaleWithin 计算有理数精确表示为十进制小数所需的最少小数位数，
+// 无法用有限位小数表示或位数超过 limit 时 ok 为 false
+//
+// 分母必须形如 2^a·5^b，所需位数为 max(a, b)。因子 2 由 TrailingZeroBits 直接得到，
+// 剩余部分由位数估算 b 后用一次乘方确认，耗时不随小数位数平方增长。
+func DecimalScaleWithin(r *big.Rat, limit int) (scale int, ok bool) {
+	denom := r.Denom()
+	twos := int(denom.TrailingZeroBits())
+	if twos > limit {
+		return 0, false
+	}
+
+	odd := new(big.Int).Rsh(denom, uint(twos))
+	fives := 0
+	if odd.Cmp(big.NewInt(1)) != 0 {
+		// 5^b 的二进制位数为 floor(b·log2(5))+1
+		fives = int(math.Ceil(float64(odd.BitLen()-1) / math.Log2(5)))
+		if fives > limit {
+			return 0, false
+		}
+		pow := new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(fives)), nil)
+		if pow.Cmp(odd) != 0 {
+			return 0, false
+		}
+	}
+
+	if twos > fives {
+		return twos, true
+	}
+	return fives, true
+}
+
+// integerDigits 整数的十进制位数（不含符号），0 的位数为 0
+func integerDigits(n *big.Int) int {
+	if n.Sign() == 0 {
+		return 0
+	}
+	return len(new(big.Int).Abs(n).String())
+}
+
+// formatRat 格式化有理数，可以用有限位小数表示时使用十进制形式
+func formatRat(r *big.Rat) string {
+	if scale, ok := DecimalScaleWithin(r, math.MaxInt); ok {
+		return r.FloatString(scale)
+	}
+	return r.RatString()
+}
+
+// mustBound 边界值为 nil 时 panic
+func mustBound[T interface{ *big.Int | *big.Rat | *big.Float }](bound T) T {
+	if bound == nil {
+		panic("primitive: bound must not be nil")
+	}
+	return bound
+}
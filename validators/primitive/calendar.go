@@ -0,0 +1,314 @@
+package primitive
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrTimeNotBusinessDay     = "must be a business day in %s calendar"
+	ErrTimeOnHoliday          = "must not be on holiday %s"
+	ErrTimeBusinessDaysAfter  = "must be at least %d business days after %s"
+	ErrTimeCalendarNotCovered = "%s calendar has no data for %d"
+)
+
+// calendarFS 内置的节假日数据
+//
+//go:embed calendars/*.json
+var calendarFS embed.FS
+
+// calendarDateLayout 节假日数据中的日期格式
+const calendarDateLayout = "2006-01-02"
+
+// HolidayCalendar 节假日日历，记录周末、节假日和调休上班日
+//
+// 日历只覆盖数据中 from 到 to 年，超出范围的日期无法判断是否为工作日。
+// 日期按时间所在时区的日历日计算，数据中指定 location 时先换算到该时区。
+type HolidayCalendar struct {
+	name     string
+	location *time.Location
+	from, to int
+	weekend  [7]bool
+	holidays map[time.Time]string
+	workdays map[time.Time]string
+}
+
+// calendarFile 节假日数据的 JSON 格式
+type calendarFile struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Location    string          `json:"location"`
+	From        int             `json:"from"`
+	To          int             `json:"to"`
+	Weekend     []string        `json:"weekend"`
+	Holidays    []calendarEntry `json:"holidays"`
+	Workdays    []calendarEntry `json:"workdays"`
+}
+
+// calendarEntry 一个日期或连续的日期区间，Until 为空时只包含 Date 当天
+type calendarEntry struct {
+	Date  string `json:"date"`
+	Until string `json:"until"`
+	Name  string `json:"name"`
+}
+
+// BuiltinHolidayCalendar 加载内置的节假日日历，name 为 "CN"（法定节假日及调休）或 "US"（联邦假日）
+//
+// CN 覆盖 2024–2026 年，日期按 Asia/Shanghai 计算；US 覆盖 2024–2030 年，
+// 联邦假日全国统一，日期按联邦政府所在地华盛顿的 America/New_York 计算。
+// 加载时区需要系统的 zoneinfo 数据库；没有时区数据的环境（如精简容器）应在 main 包中导入 time/tzdata。
+func BuiltinHolidayCalendar(name string) (*HolidayCalendar, error) {
+	data, err := calendarFS.ReadFile("calendars/" + strings.ToLower(name) + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("unknown holiday calendar %q", name)
+	}
+	return ParseHolidayCalendar(data)
+}
+
+// LoadHolidayCalendar 从 JSON 读取节假日日历
+func LoadHolidayCalendar(r io.Reader) (*HolidayCalendar, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHolidayCalendar(data)
+}
+
+// ParseHolidayCalendar 解析 JSON 格式的节假日日历，格式与 calendars 目录下的内置数据相同：
+//
+//	{
+//	  "name": "CN",
+//	  "location": "Asia/Shanghai",
+//	  "from": 2025, "to": 2025,
+//	  "weekend": ["Saturday", "Sunday"],
+//	  "holidays": [{"date": "2025-10-01", "until": "2025-10-08", "name": "国庆节"}],
+//	  "workdays": [{"date": "2025-09-28", "name": "国庆节调休"}]
+//	}
+//
+// location 可省略，指定时需要系统的 zoneinfo 数据库或在 main 包中导入 time/tzdata；weekend 省略时为周六、周日；
+// holidays 和 workdays 中的日期必须在 from 到 to 年之内。
+func ParseHolidayCalendar(data []byte) (*HolidayCalendar, error) {
+	var file calendarFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid holiday calendar: %w", err)
+	}
+	if file.Name == "" {
+		return nil, fmt.Errorf("invalid holiday calendar: name is required")
+	}
+	if file.From <= 0 || file.To < file.From {
+		return nil, fmt.Errorf("holiday calendar %s: invalid year range %d-%d", file.Name, file.From, file.To)
+	}
+
+	c := &HolidayCalendar{
+		name:     file.Name,
+		from:     file.From,
+		to:       file.To,
+		holidays: make(map[time.Time]string),
+		workdays: make(map[time.Time]string),
+	}
+
+	if file.Location != "" {
+		loc, err := time.LoadLocation(file.Location)
+		if err != nil {
+			return nil, fmt.Errorf("holiday calendar %s: %w", file.Name, err)
+		}
+		c.location = loc
+	}
+
+	if file.Weekend == nil {
+		file.Weekend = []string{"Saturday", "Sunday"}
+	}
+	for _, name := range file.Weekend {
+		day, ok := parseWeekday(name)
+		if !ok {
+			return nil, fmt.Errorf("holiday calendar %s: invalid weekday %q", file.Name, name)
+		}
+		c.weekend[day] = true
+	}
+
+	if err := c.addEntries(file.Holidays, c.holidays); err != nil {
+		return nil, err
+	}
+	if err := c.addEntries(file.Workdays, c.workdays); err != nil {
+		return nil, err
+	}
+	for date := range c.workdays {
+		if name, ok := c.holidays[date]; ok {
+			return nil, fmt.Errorf("holiday calendar %s: %s is both holiday %s and workday", c.name, date.Format(calendarDateLayout), name)
+		}
+	}
+	return c, nil
+}
+
+// Name 日历名称
+func (c *HolidayCalendar) Name() string {
+	return c.name
+}
+
+// Covers 判断日期是否在日历覆盖的年份内
+func (c *HolidayCalendar) Covers(t time.Time) bool {
+	year := c.date(t).Year()
+	return year >= c.from && year <= c.to
+}
+
+// Holiday 返回日期对应的节假日名称，不是节假日时 ok 为 false；周末不视为节假日
+func (c *HolidayCalendar) Holiday(t time.Time) (name string, ok bool) {
+	name, ok = c.holidays[c.date(t)]
+	return name, ok
+}
+
+// IsBusinessDay 判断是否为工作日：调休上班日是工作日，否则节假日和周末都不是工作日；
+// 日期不在覆盖范围内时返回错误
+func (c *HolidayCalendar) IsBusinessDay(t time.Time) (bool, error) {
+	date := c.date(t)
+	if year := date.Year(); year < c.from || year > c.to {
+		return false, c.notCovered(year)
+	}
+	return c.isBusinessDay(date), nil
+}
+
+// AddBusinessDays 返回 t 所在日期之后的第 n 个工作日的零点，时区为日历的 location，未指定时与 t 相同；n 为 0 时返回当天零点；
+// 推算超出覆盖范围时返回错误，n 小于 0 时 panic
+func (c *HolidayCalendar) AddBusinessDays(t time.Time, n int) (time.Time, error) {
+	if n < 0 {
+		panic(fmt.Sprintf("primitive: business days must not be negative, got %d", n))
+	}
+
+	date := c.date(t)
+	for n > 0 {
+		date = date.AddDate(0, 0, 1)
+		if year := date.Year(); year < c.from || year > c.to {
+			return time.Time{}, c.notCovered(year)
+		}
+		if c.isBusinessDay(date) {
+			n--
+		}
+	}
+
+	loc := t.Location()
+	if c.location != nil {
+		loc = c.location
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc), nil
+}
+
+// IsBusinessDay 验证时间是日历中的工作日；calendar 为 nil 时 panic
+func (v *TimeValidator) IsBusinessDay(calendar *HolidayCalendar) hvalid.ValidatorFunc[time.Time] {
+	mustCalendar(calendar)
+	return v.rule(func(value time.Time) string {
+		ok, err := calendar.IsBusinessDay(value)
+		if err != nil {
+			return err.Error()
+		}
+		if !ok {
+			return fmt.Sprintf(ErrTimeNotBusinessDay, calendar.name)
+		}
+		return ""
+	})
+}
+
+// NotOnHoliday 验证时间不在节假日，周末和调休上班日都可以通过；calendar 为 nil 时 panic
+func (v *TimeValidator) NotOnHoliday(calendar *HolidayCalendar) hvalid.ValidatorFunc[time.Time] {
+	mustCalendar(calendar)
+	return v.rule(func(value time.Time) string {
+		if !calendar.Covers(value) {
+			return calendar.notCovered(calendar.date(value).Year()).Error()
+		}
+		if name, ok := calendar.Holiday(value); ok {
+			return fmt.Sprintf(ErrTimeOnHoliday, name)
+		}
+		return ""
+	})
+}
+
+// AtLeastNBusinessDaysAfter 验证时间所在日期不早于今天之后的第 n 个工作日，今天由 Clock 决定；
+// 例如 n 为 2 时，周五下单最早可以在下周二交付。calendar 为 nil 或 n 小于 0 时 panic
+func (v *TimeValidator) AtLeastNBusinessDaysAfter(calendar *HolidayCalendar, n int) hvalid.ValidatorFunc[time.Time] {
+	mustCalendar(calendar)
+	if n < 0 {
+		panic(fmt.Sprintf("primitive: business days must not be negative, got %d", n))
+	}
+
+	return v.rule(func(value time.Time) string {
+		now := v.now().In(value.Location())
+		earliest, err := calendar.AddBusinessDays(now, n)
+		if err != nil {
+			return err.Error()
+		}
+		if calendar.date(value).Before(calendar.date(earliest)) {
+			return fmt.Sprintf(ErrTimeBusinessDaysAfter, n, calendar.date(now).Format(calendarDateLayout))
+		}
+		return ""
+	})
+}
+
+// mustCalendar 日历为 nil 时 panic
+func mustCalendar(calendar *HolidayCalendar) {
+	if calendar == nil {
+		panic("primitive: holiday calendar must not be nil")
+	}
+}
+
+// isBusinessDay 判断日历日是否为工作日
+func (c *HolidayCalendar) isBusinessDay(date time.Time) bool {
+	if _, ok := c.workdays[date]; ok {
+		return true
+	}
+	if _, ok := c.holidays[date]; ok {
+		return false
+	}
+	return !c.weekend[date.Weekday()]
+}
+
+// date 将时间换算为日历日，以 UTC 零点表示以便作为 map 的键
+func (c *HolidayCalendar) date(t time.Time) time.Time {
+	if c.location != nil {
+		t = t.In(c.location)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// addEntries 展开日期区间并写入 dates
+func (c *HolidayCalendar) addEntries(entries []calendarEntry, dates map[time.Time]string) error {
+	for _, entry := range entries {
+		start, err := time.Parse(calendarDateLayout, entry.Date)
+		if err != nil {
+			return fmt.Errorf("holiday calendar %s: invalid date %q", c.name, entry.Date)
+		}
+		end := start
+		if entry.Until != "" {
+			if end, err = time.Parse(calendarDateLayout, entry.Until); err != nil || end.Before(start) {
+				return fmt.Errorf("holiday calendar %s: invalid date range %s..%s", c.name, entry.Date, entry.Until)
+			}
+		}
+		if start.Year() < c.from || end.Year() > c.to {
+			return fmt.Errorf("holiday calendar %s: %s..%s is outside %d-%d", c.name, start.Format(calendarDateLayout), end.Format(calendarDateLayout), c.from, c.to)
+		}
+		for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+			dates[date] = entry.Name
+		}
+	}
+	return nil
+}
+
+// notCovered 超出覆盖范围的错误
+func (c *HolidayCalendar) notCovered(year int) error {
+	return fmt.Errorf(ErrTimeCalendarNotCovered, c.name, year)
+}
+
+// parseWeekday 解析英文星期名称，不区分大小写
+func parseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), name) {
+			return day, true
+		}
+	}
+	return 0, false
+}
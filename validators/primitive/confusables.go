@@ -0,0 +1,174 @@
+package primitive
+
+// confusables Unicode confusables 表（UTS #39 confusables.txt）中常见拉丁字母仿冒字符的子集，
+// 值为对应的原型字符序列
+var confusables = map[rune]string{
+	// ASCII
+	'0': "O",
+	'1': "l",
+	'I': "l",
+	'|': "l",
+	'm': "rn",
+
+	// 拉丁字母扩展
+	'ı': "i",
+	'ȷ': "j",
+	'ɑ': "a",
+	'ɡ': "g",
+	'ɩ': "i",
+	'ɪ': "i",
+	'ʏ': "y",
+	'ǀ': "l",
+	'ℓ': "l",
+	'ℐ': "l",
+	'ℑ': "l",
+	'ℹ': "i",
+	'ⅰ': "i",
+	'ⅼ': "l",
+	'ⅽ': "c",
+	'ⅾ': "d",
+	'ⅿ': "rn",
+	'Ⅰ': "l",
+	'Ⅴ': "V",
+	'Ⅹ': "X",
+	'Ⅼ': "L",
+	'Ⅽ': "C",
+	'Ⅾ': "D",
+	'Ⅿ': "M",
+
+	// 希腊字母
+	'Α': "A",
+	'Β': "B",
+	'Ε': "E",
+	'Ζ': "Z",
+	'Η': "H",
+	'Ι': "l",
+	'Κ': "K",
+	'Μ': "M",
+	'Ν': "N",
+	'Ο': "O",
+	'Ρ': "P",
+	'Τ': "T",
+	'Υ': "Y",
+	'Χ': "X",
+	'α': "a",
+	'γ': "y",
+	'ι': "i",
+	'ν': "v",
+	'ο': "o",
+	'ρ': "p",
+	'σ': "o",
+	'υ': "u",
+
+	// 西里尔字母
+	'А': "A",
+	'В': "B",
+	'Е': "E",
+	'З': "3",
+	'К': "K",
+	'М': "M",
+	'Н': "H",
+	'О': "O",
+	'Р': "P",
+	'С': "C",
+	'Т': "T",
+	'Х': "X",
+	'Ѕ': "S",
+	'І': "l",
+	'Ј': "J",
+	'Ү': "Y",
+	'Ԁ': "d",
+	'Ԛ': "Q",
+	'Ԝ': "W",
+	'а': "a",
+	'в': "B",
+	'е': "e",
+	'н': "H",
+	'о': "o",
+	'р': "p",
+	'с': "c",
+	'у': "y",
+	'х': "x",
+	'ѕ': "s",
+	'і': "i",
+	'ј': "j",
+	'ӏ': "l",
+	'ԁ': "d",
+	'ԛ': "q",
+	'ԝ': "w",
+	'һ': "h",
+	'ү': "y",
+
+	// 亚美尼亚字母
+	'օ': "o",
+	'ս': "u",
+	'հ': "h",
+	'ո': "n",
+	'ց': "g",
+	'Ս': "U",
+	'Օ': "O",
+
+	// 全角字符
+	'０': "O",
+	'１': "l",
+	'２': "2",
+	'３': "3",
+	'４': "4",
+	'５': "5",
+	'６': "6",
+	'７': "7",
+	'８': "8",
+	'９': "9",
+	'Ａ': "A",
+	'Ｂ': "B",
+	'Ｃ': "C",
+	'Ｄ': "D",
+	'Ｅ': "E",
+	'Ｆ': "F",
+	'Ｇ': "G",
+	'Ｈ': "H",
+	'Ｉ': "l",
+	'Ｊ': "J",
+	'Ｋ': "K",
+	'Ｌ': "L",
+	'Ｍ': "M",
+	'Ｎ': "N",
+	'Ｏ': "O",
+	'Ｐ': "P",
+	'Ｑ': "Q",
+	'Ｒ': "R",
+	'Ｓ': "S",
+	'Ｔ': "T",
+	'Ｕ': "U",
+	'Ｖ': "V",
+	'Ｗ': "W",
+	'Ｘ': "X",
+	'Ｙ': "Y",
+	'Ｚ': "Z",
+	'ａ': "a",
+	'ｂ': "b",
+	'ｃ': "c",
+	'ｄ': "d",
+	'ｅ': "e",
+	'ｆ': "f",
+	'ｇ': "g",
+	'ｈ': "h",
+	'ｉ': "i",
+	'ｊ': "j",
+	'ｋ': "k",
+	'ｌ': "l",
+	'ｍ': "rn",
+	'ｎ': "n",
+	'ｏ': "o",
+	'ｐ': "p",
+	'ｑ': "q",
+	'ｒ': "r",
+	'ｓ': "s",
+	'ｔ': "t",
+	'ｕ': "u",
+	'ｖ': "v",
+	'ｗ': "w",
+	'ｘ': "x",
+	'ｙ': "y",
+	'ｚ': "z",
+}
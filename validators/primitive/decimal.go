@@ -0,0 +1,154 @@
+package primitive
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrDecimalInvalid      = "must be a valid decimal number"
+	ErrDecimalNotCanonical = "must be in canonical decimal form"
+)
+
+// maxDecimalLength 十进制数字符串的最大长度，避免超长输入在解析和计算小数位数时耗费过多 CPU
+const maxDecimalLength = 1024
+
+// DecimalValidator 十进制数字符串验证器结构体，例如 "12345.6789"
+//
+// 接受可选的正负号、整数部分和小数部分，不接受指数形式。
+// 小数位数按数值计算，末尾的 0 不计入，例如 "1.50" 的小数位数为 1。
+// 超过 1024 个字符的字符串视为无效。
+type DecimalValidator struct {
+	FieldName string // 字段名称
+}
+
+// NewDecimalValidator 创建十进制数字符串验证器
+func NewDecimalValidator(fieldName string) *DecimalValidator {
+	return &DecimalValidator{
+		FieldName: fieldName,
+	}
+}
+
+// ParseDecimal 将十进制数字符串精确解析为 *big.Rat，超过 1024 个字符时返回错误
+func ParseDecimal(s string) (*big.Rat, error) {
+	r, _, err := parseDecimal(s)
+	return r, err
+}
+
+// Valid 验证是合法的十进制数
+func (v *DecimalValidator) Valid() hvalid.ValidatorFunc[string] {
+	return v.rule(func(*big.Rat) string { return "" })
+}
+
+// Canonical 验证是规范形式：没有正号、没有多余的前导零、不以小数点开头或结尾、没有负零，例如 "0.50"、"-12"
+func (v *DecimalValidator) Canonical() hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		_, canonical, err := parseDecimal(field)
+		if err != nil {
+			validationErr.AddError(ErrDecimalInvalid)
+			return validationErr
+		}
+		if !canonical {
+			validationErr.AddError(ErrDecimalNotCanonical)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// Min 验证最小值，min 不是合法的十进制数时 panic
+func (v *DecimalValidator) Min(min string) hvalid.ValidatorFunc[string] {
+	return v.rule(ratMin(mustParseDecimal(min)))
+}
+
+// Max 验证最大值，max 不是合法的十进制数时 panic
+func (v *DecimalValidator) Max(max string) hvalid.ValidatorFunc[string] {
+	return v.rule(ratMax(mustParseDecimal(max)))
+}
+
+// NonNegative 验证不是负数，"-0" 视为非负
+func (v *DecimalValidator) NonNegative() hvalid.ValidatorFunc[string] {
+	return v.rule(ratNonNegative)
+}
+
+// MaxScale 验证小数位数不超过 scale
+func (v *DecimalValidator) MaxScale(scale int) hvalid.ValidatorFunc[string] {
+	return v.rule(ratScale(scale))
+}
+
+// Precision 验证可以精确存入 SQL DECIMAL(precision,scale)，参数无效时 panic
+func (v *DecimalValidator) Precision(precision, scale int) hvalid.ValidatorFunc[string] {
+	return v.rule(ratPrecision(precision, scale))
+}
+
+// rule 解析字符串后执行检查，检查函数返回空字符串表示通过
+func (v *DecimalValidator) rule(check func(*big.Rat) string) hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		r, _, err := parseDecimal(field)
+		if err != nil {
+			validationErr.AddError(ErrDecimalInvalid)
+			return validationErr
+		}
+		if message := check(r); message != "" {
+			validationErr.AddError(message)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// parseDecimal 解析十进制数字符串，同时判断是否为规范形式
+func parseDecimal(s string) (r *big.Rat, canonical bool, err error) {
+	if len(s) > maxDecimalLength {
+		return nil, false, fmt.Errorf("decimal longer than %d characters", maxDecimalLength)
+	}
+
+	digits := s
+	sign := ""
+	if digits != "" && (digits[0] == '+' || digits[0] == '-') {
+		sign, digits = digits[:1], digits[1:]
+	}
+
+	intPart, fracPart, hasPoint := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" {
+		return nil, false, fmt.Errorf("invalid decimal %q", s)
+	}
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return nil, false, fmt.Errorf("invalid decimal %q", s)
+			}
+		}
+	}
+
+	num, ok := new(big.Int).SetString(sign+intPart+fracPart, 10)
+	if !ok {
+		return nil, false, fmt.Errorf("invalid decimal %q", s)
+	}
+	denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fracPart))), nil)
+	r = new(big.Rat).SetFrac(num, denom)
+
+	canonical = sign != "+" &&
+		intPart != "" &&
+		(len(intPart) == 1 || intPart[0] != '0') &&
+		(!hasPoint || fracPart != "") &&
+		!(sign == "-" && r.Sign() == 0)
+	return r, canonical, nil
+}
+
+// mustParseDecimal 解析边界值，失败时 panic
+func mustParseDecimal(s string) *big.Rat {
+	r, err := ParseDecimal(s)
+	if err != nil {
+		panic("primitive: " + err.Error())
+	}
+	return r
+}
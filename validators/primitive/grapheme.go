@@ -0,0 +1,152 @@
+package primitive
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// 字素簇断行属性，参见 UAX #29
+const (
+	gbOther = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+	gbExtendedPictographic
+)
+
+// extendedPictographic Extended_Pictographic 属性的近似范围
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x23ff, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1},
+		{Lo: 0x1f10d, Hi: 0x1f1e5, Stride: 1},
+		{Lo: 0x1f200, Hi: 0x1f3fa, Stride: 1},
+		{Lo: 0x1f400, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+	},
+}
+
+// graphemeBreakProperty 获取字符的断行属性
+func graphemeBreakProperty(r rune) int {
+	switch {
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r == 0x200d:
+		return gbZWJ
+	case r == 0x200c, r >= 0x1f3fb && r <= 0x1f3ff, r >= 0xe0020 && r <= 0xe007f:
+		// ZWNJ、肤色修饰符和标签字符属于 Extend
+		return gbExtend
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return gbRegionalIndicator
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return gbL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return gbV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return gbT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return gbLV
+		}
+		return gbLVT
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return gbExtend
+	case unicode.Is(unicode.Mc, r):
+		return gbSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gbControl
+	case unicode.Is(extendedPictographic, r):
+		return gbExtendedPictographic
+	default:
+		return gbOther
+	}
+}
+
+// graphemeCount 统计扩展字素簇数量
+//
+// 实现 UAX #29 的主要断行规则（CR LF、控制字符、韩文音节、Extend/ZWJ/SpacingMark、
+// emoji ZWJ 序列和区域指示符对），不包含 Prepend 和印度系文字的辅音连缀规则。
+func graphemeCount(s string) int {
+	count := 0
+	prev := -1
+	riCount := 0          // 连续区域指示符的数量
+	pictographic := false // 当前簇是否处于 ExtPict Extend* 序列中
+
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+
+		prop := graphemeBreakProperty(r)
+		if prev < 0 || graphemeBreak(prev, prop, riCount, pictographic) {
+			count++
+			riCount = 0
+			pictographic = false
+		}
+
+		switch prop {
+		case gbRegionalIndicator:
+			riCount++
+		case gbExtendedPictographic:
+			pictographic = true
+		case gbExtend, gbZWJ:
+		default:
+			pictographic = false
+		}
+		prev = prop
+	}
+	return count
+}
+
+// graphemeBreak 判断两个字符之间是否断开
+func graphemeBreak(prev, next, riCount int, pictographic bool) bool {
+	switch {
+	case prev == gbCR && next == gbLF: // GB3
+		return false
+	case prev == gbCR || prev == gbLF || prev == gbControl: // GB4
+		return true
+	case next == gbCR || next == gbLF || next == gbControl: // GB5
+		return true
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT): // GB6
+		return false
+	case (prev == gbLV || prev == gbV) && (next == gbV || next == gbT): // GB7
+		return false
+	case (prev == gbLVT || prev == gbT) && next == gbT: // GB8
+		return false
+	case next == gbExtend || next == gbZWJ || next == gbSpacingMark: // GB9、GB9a
+		return false
+	case prev == gbZWJ && next == gbExtendedPictographic && pictographic: // GB11
+		return false
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator: // GB12、GB13
+		return riCount%2 == 0
+	default: // GB999
+		return true
+	}
+}
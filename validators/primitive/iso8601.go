@@ -0,0 +1,344 @@
+package primitive
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strings"
+	"time"
+)
+
+// ISODuration ISO 8601 时长，例如 P1Y2M3DT4H5M6.5S
+//
+// 年、月、周、天是日历单位，长度随日期变化，单独保存；时、分、秒合并为 Time。
+type ISODuration struct {
+	Years  int           // 年
+	Months int           // 月
+	Weeks  int           // 周
+	Days   int           // 天
+	Time   time.Duration // T 之后的时、分、秒
+}
+
+// AddTo 将时长加到 t 上，先按日历加年、月、天，再加时、分、秒
+func (d ISODuration) AddTo(t time.Time) time.Time {
+	return t.AddDate(d.Years, d.Months, d.Weeks*7+d.Days).Add(d.Time)
+}
+
+// IsZero 判断时长是否为 0
+func (d ISODuration) IsZero() bool {
+	return d == ISODuration{}
+}
+
+// ParseISO8601Date 解析 ISO 8601 日期，返回 UTC 零点，支持扩展格式和基本格式：
+//
+//	日历日期 2025-10-19、20251019
+//	周日期   2025-W42-7、2025W427
+//	序数日期 2025-292、2025292
+func ParseISO8601Date(s string) (time.Time, error) {
+	t, ok := parseISODate(s)
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid ISO 8601 date %q", s)
+	}
+	return t, nil
+}
+
+// ParseISO8601 解析 ISO 8601 日期或日期时间，日期部分同 ParseISO8601Date，
+// 时间部分为 hh:mm[:ss[.fff]] 或 hhmm[ss[.fff]]，时区为 Z、±hh:mm、±hhmm 或 ±hh；
+// 没有时区时按 UTC 解析
+func ParseISO8601(s string) (time.Time, error) {
+	datePart, timePart, hasTime := strings.Cut(s, "T")
+	date, ok := parseISODate(datePart)
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid ISO 8601 date-time %q", s)
+	}
+	if !hasTime {
+		return date, nil
+	}
+
+	clock, loc, ok := parseISOTime(timePart)
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid ISO 8601 date-time %q", s)
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc).Add(clock), nil
+}
+
+// ParseISODuration 解析 ISO 8601 时长 PnYnMnWnDTnHnMnS，各部分按顺序出现且至少有一个；
+// 只有最后一个时、分、秒部分可以带小数，例如 P3DT4H、PT1.5H、P1W
+func ParseISODuration(s string) (ISODuration, error) {
+	var d ISODuration
+	invalid := fmt.Errorf("invalid ISO 8601 duration %q", s)
+
+	rest, ok := strings.CutPrefix(s, "P")
+	if !ok || rest == "" {
+		return d, invalid
+	}
+	datePart, timePart, hasTime := strings.Cut(rest, "T")
+	if hasTime && timePart == "" {
+		return d, invalid
+	}
+
+	dateFields := []struct {
+		unit  byte
+		value *int
+	}{{'Y', &d.Years}, {'M', &d.Months}, {'W', &d.Weeks}, {'D', &d.Days}}
+	next := 0
+	for datePart != "" {
+		digits, fraction, unit, remain, ok := cutDurationComponent(datePart)
+		if !ok || fraction != "" {
+			return d, invalid
+		}
+		for next < len(dateFields) && dateFields[next].unit != unit {
+			next++
+		}
+		if next == len(dateFields) || len(digits) > 9 {
+			return d, invalid
+		}
+		*dateFields[next].value = atoiDigits(digits)
+		next++
+		datePart = remain
+	}
+
+	timeUnits := []struct {
+		unit byte
+		size time.Duration
+	}{{'H', time.Hour}, {'M', time.Minute}, {'S', time.Second}}
+	next = 0
+	for timePart != "" {
+		digits, fraction, unit, remain, ok := cutDurationComponent(timePart)
+		if !ok || (fraction != "" && remain != "") {
+			return d, invalid
+		}
+		for next < len(timeUnits) && timeUnits[next].unit != unit {
+			next++
+		}
+		if next == len(timeUnits) {
+			return d, invalid
+		}
+		value, ok := durationComponent(digits, fraction, timeUnits[next].size)
+		if !ok || d.Time > math.MaxInt64-value {
+			return d, invalid
+		}
+		d.Time += value
+		next++
+		timePart = remain
+	}
+	return d, nil
+}
+
+// parseISODate 解析 ISO 8601 日历日期、周日期或序数日期
+func parseISODate(s string) (time.Time, bool) {
+	if len(s) < 7 || !allDigits(s[:4]) {
+		return time.Time{}, false
+	}
+	year := atoiDigits(s[:4])
+
+	rest := s[4:]
+	extended := rest[0] == '-'
+	if extended {
+		rest = rest[1:]
+	}
+
+	// 周日期：Www-D 或 WwwD
+	if week, ok := strings.CutPrefix(rest, "W"); ok {
+		if extended {
+			if len(week) != 4 || week[2] != '-' {
+				return time.Time{}, false
+			}
+			week = week[:2] + week[3:]
+		}
+		if len(week) != 3 || !allDigits(week) {
+			return time.Time{}, false
+		}
+		return isoWeekDate(year, atoiDigits(week[:2]), atoiDigits(week[2:]))
+	}
+
+	switch {
+	case len(rest) == 3 && allDigits(rest):
+		// 序数日期
+		day := atoiDigits(rest)
+		if day < 1 || day > time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() {
+			return time.Time{}, false
+		}
+		return time.Date(year, time.January, day, 0, 0, 0, 0, time.UTC), true
+	case extended && len(rest) == 5 && rest[2] == '-':
+		rest = rest[:2] + rest[3:]
+	case extended || len(rest) != 4:
+		return time.Time{}, false
+	}
+
+	// 日历日期
+	if !allDigits(rest) {
+		return time.Time{}, false
+	}
+	month, day := atoiDigits(rest[:2]), atoiDigits(rest[2:])
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if month < 1 || month > 12 || day < 1 || t.Day() != day {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// isoWeekDate 将 ISO 周日期转换为日期，第 1 周是包含 1 月 4 日的那一周
+func isoWeekDate(year, week, weekday int) (time.Time, bool) {
+	if _, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek(); week < 1 || week > weeks {
+		return time.Time{}, false
+	}
+	if weekday < 1 || weekday > 7 {
+		return time.Time{}, false
+	}
+
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -(int(jan4.Weekday())+6)%7)
+	return monday.AddDate(0, 0, (week-1)*7+weekday-1), true
+}
+
+// parseISOTime 解析时间和时区，返回距零点的时长和时区
+func parseISOTime(s string) (time.Duration, *time.Location, bool) {
+	loc := time.UTC
+	if i := strings.IndexAny(s, "Z+-"); i >= 0 {
+		zone, ok := parseISOZone(s[i:])
+		if !ok {
+			return 0, nil, false
+		}
+		s, loc = s[:i], zone
+	}
+
+	clock, fraction, _ := strings.Cut(strings.Replace(s, ",", ".", 1), ".")
+	extended := strings.Contains(clock, ":")
+	if extended {
+		clock = strings.ReplaceAll(clock, ":", "")
+		if len(s) < 5 || s[2] != ':' || (len(clock) == 6 && s[5] != ':') {
+			return 0, nil, false
+		}
+	}
+	if (len(clock) != 4 && len(clock) != 6) || !allDigits(clock) || (fraction != "" && len(clock) != 6) {
+		return 0, nil, false
+	}
+
+	hour, minute, second := atoiDigits(clock[:2]), atoiDigits(clock[2:4]), 0
+	if len(clock) == 6 {
+		second = atoiDigits(clock[4:])
+	}
+	if hour > 23 || minute > 59 || second > 59 {
+		return 0, nil, false
+	}
+
+	offset := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second
+	if fraction != "" {
+		nanos, ok := durationComponent("0", fraction, time.Second)
+		if !ok {
+			return 0, nil, false
+		}
+		offset += nanos
+	}
+	return offset, loc, true
+}
+
+// parseISOZone 解析时区 Z、±hh:mm、±hhmm 或 ±hh
+func parseISOZone(s string) (*time.Location, bool) {
+	if s == "Z" {
+		return time.UTC, true
+	}
+
+	digits := strings.Replace(s[1:], ":", "", 1)
+	if (len(digits) != 2 && len(digits) != 4) || !allDigits(digits) || (len(s) == 6 && s[3] != ':') {
+		return nil, false
+	}
+	hours, minutes := atoiDigits(digits[:2]), 0
+	if len(digits) == 4 {
+		minutes = atoiDigits(digits[2:])
+	}
+	if hours > 23 || minutes > 59 {
+		return nil, false
+	}
+
+	offset := hours*3600 + minutes*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone("", offset), true
+}
+
+// cutDurationComponent 切出时长中的一个部分，例如 "1.5H30M" 切出 "1"、"5"、'H'，剩余 "30M"
+func cutDurationComponent(s string) (digits, fraction string, unit byte, rest string, ok bool) {
+	i := 0
+	for i < len(s) && isDigitByte(s[i]) {
+		i++
+	}
+	if i == 0 {
+		return "", "", 0, "", false
+	}
+	digits = s[:i]
+
+	if i < len(s) && (s[i] == '.' || s[i] == ',') {
+		start := i + 1
+		i = start
+		for i < len(s) && isDigitByte(s[i]) {
+			i++
+		}
+		if i == start {
+			return "", "", 0, "", false
+		}
+		fraction = s[start:i]
+	}
+
+	if i == len(s) {
+		return "", "", 0, "", false
+	}
+	return digits, fraction, s[i], s[i+1:], true
+}
+
+// durationComponent 计算 digits.fraction 个 unit 的时长，小数部分截断到纳秒，溢出时 ok 为 false
+func durationComponent(digits, fraction string, unit time.Duration) (time.Duration, bool) {
+	if len(digits) > 18 {
+		return 0, false
+	}
+	whole := uint64(atoiDigits(digits))
+	if whole > math.MaxInt64/uint64(unit) {
+		return 0, false
+	}
+	d := time.Duration(whole) * unit
+
+	// 小数部分为 num/10^k 个 unit，超过 18 位的部分不影响纳秒精度
+	if len(fraction) > 18 {
+		fraction = fraction[:18]
+	}
+	if fraction != "" {
+		num, denom := uint64(atoiDigits(fraction)), uint64(1)
+		for range fraction {
+			denom *= 10
+		}
+		// num < denom，商一定小于 unit，不会溢出
+		hi, lo := bits.Mul64(num, uint64(unit))
+		nanos, _ := bits.Div64(hi, lo, denom)
+		d += time.Duration(nanos)
+	}
+	return d, d >= 0
+}
+
+// allDigits 判断字符串非空且全部是 ASCII 数字
+func allDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigitByte(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigitByte 判断是否为 ASCII 数字
+func isDigitByte(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// atoiDigits 将已确认全部是数字的字符串转换为整数
+func atoiDigits(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	return n
+}
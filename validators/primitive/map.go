@@ -1,7 +1,11 @@
 package primitive
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
 
 	"github.com/lyonnee/hvalid"
 )
@@ -14,6 +18,11 @@ const (
 	ErrMapNotEmpty = "must be empty"
 	ErrMapHasKey   = "must not contain key: %v"
 	ErrMapNoKey    = "must contain key: %v"
+
+	ErrMapInvalidKey   = "key[%s]: invalid key: %v"
+	ErrMapMissingKey   = "key[%s]: is required"
+	ErrMapExtraKey     = "key[%s]: is not allowed"
+	ErrMapRequiredWhen = "key[%s]: is required when %s"
 )
 
 // MapValidator Map验证器结构体
@@ -105,3 +114,207 @@ func (v *MapValidator[K, V]) NoKey(key K) hvalid.ValidatorFunc[map[K]V] {
 		return nil
 	})
 }
+
+// EachKey 验证每个键
+func (v *MapValidator[K, V]) EachKey(rule hvalid.ValidatorFunc[K]) hvalid.ValidatorFunc[map[K]V] {
+	return hvalid.ValidatorFunc[map[K]V](func(m map[K]V) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for _, key := range SortedKeys(m) {
+			if err := rule(key); err != nil {
+				validationErr.AddError(fmt.Sprintf(ErrMapInvalidKey, FormatMapKey(key), err))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// EachValue 验证每个值
+func (v *MapValidator[K, V]) EachValue(rule hvalid.ValidatorFunc[V]) hvalid.ValidatorFunc[map[K]V] {
+	return hvalid.ValidatorFunc[map[K]V](func(m map[K]V) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for _, key := range SortedKeys(m) {
+			if err := rule(m[key]); err != nil {
+				AddPathError(validationErr, KeyPath(key), err)
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// RequiredKeys 验证包含所有指定键
+func (v *MapValidator[K, V]) RequiredKeys(keys ...K) hvalid.ValidatorFunc[map[K]V] {
+	return hvalid.ValidatorFunc[map[K]V](func(m map[K]V) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for _, key := range keys {
+			if _, exists := m[key]; !exists {
+				validationErr.AddError(fmt.Sprintf(ErrMapMissingKey, FormatMapKey(key)))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// AllowedKeys 验证所有键都在允许的集合中
+func (v *MapValidator[K, V]) AllowedKeys(keys ...K) hvalid.ValidatorFunc[map[K]V] {
+	allowed := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		allowed[key] = struct{}{}
+	}
+
+	return hvalid.ValidatorFunc[map[K]V](func(m map[K]V) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for _, key := range SortedKeys(m) {
+			if _, ok := allowed[key]; !ok {
+				validationErr.AddError(fmt.Sprintf(ErrMapExtraKey, FormatMapKey(key)))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// When 当 condition 对整个 map 成立时执行验证，用于条件键
+func (v *MapValidator[K, V]) When(condition func(map[K]V) bool, rule hvalid.ValidatorFunc[map[K]V]) hvalid.ValidatorFunc[map[K]V] {
+	return hvalid.ValidatorFunc[map[K]V](func(m map[K]V) error {
+		if !condition(m) {
+			return nil
+		}
+		return rule(m)
+	})
+}
+
+// RequiredWhen 当 condition 成立时要求包含指定键，description 用于错误信息，例如 `type is "s3"`
+func (v *MapValidator[K, V]) RequiredWhen(condition func(map[K]V) bool, description string, keys ...K) hvalid.ValidatorFunc[map[K]V] {
+	return hvalid.ValidatorFunc[map[K]V](func(m map[K]V) error {
+		if !condition(m) {
+			return nil
+		}
+
+		validationErr := hvalid.NewValidationError(v.FieldName)
+		for _, key := range keys {
+			if _, exists := m[key]; !exists {
+				validationErr.AddError(fmt.Sprintf(ErrMapRequiredWhen, FormatMapKey(key), description))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// KeyEquals 返回判断指定键的值是否等于 value 的条件，值使用 reflect.DeepEqual 比较
+func KeyEquals[K comparable, V any](key K, value V) func(map[K]V) bool {
+	return func(m map[K]V) bool {
+		actual, exists := m[key]
+		return exists && reflect.DeepEqual(actual, value)
+	}
+}
+
+// MapSchema map 结构定义
+type MapSchema[K comparable, V any] struct {
+	Required []K                                // 必须存在的键
+	Keys     map[K]hvalid.ValidatorFunc[V]      // 指定键的值规则，键不存在时跳过
+	Patterns map[string]hvalid.ValidatorFunc[V] // 键匹配正则时应用的值规则，键会以 fmt.Sprint 转换为字符串
+	Strict   bool                               // 为 true 时，不在 Required、Keys 中且不匹配任何 Patterns 的键视为多余
+	Rules    []hvalid.ValidatorFunc[map[K]V]    // 作用于整个 map 的附加规则，例如 RequiredWhen
+}
+
+// Schema 按结构定义验证 map，Patterns 中的正则表达式无效时 panic
+func (v *MapValidator[K, V]) Schema(schema MapSchema[K, V]) hvalid.ValidatorFunc[map[K]V] {
+	type patternRule struct {
+		pattern *regexp.Regexp
+		rule    hvalid.ValidatorFunc[V]
+	}
+
+	patterns := make([]patternRule, 0, len(schema.Patterns))
+	for pattern, rule := range schema.Patterns {
+		patterns = append(patterns, patternRule{pattern: MustCompileRegexp(pattern), rule: rule})
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		return patterns[i].pattern.String() < patterns[j].pattern.String()
+	})
+
+	declared := make(map[K]struct{}, len(schema.Required)+len(schema.Keys))
+	for _, key := range schema.Required {
+		declared[key] = struct{}{}
+	}
+	for key := range schema.Keys {
+		declared[key] = struct{}{}
+	}
+
+	return hvalid.ValidatorFunc[map[K]V](func(m map[K]V) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for _, key := range schema.Required {
+			if _, exists := m[key]; !exists {
+				validationErr.AddError(fmt.Sprintf(ErrMapMissingKey, FormatMapKey(key)))
+			}
+		}
+
+		for _, key := range SortedKeys(m) {
+			value := m[key]
+			matched := false
+
+			if rule, ok := schema.Keys[key]; ok {
+				matched = true
+				if err := rule(value); err != nil {
+					AddPathError(validationErr, KeyPath(key), err)
+				}
+			}
+
+			name := fmt.Sprint(key)
+			for _, p := range patterns {
+				if !p.pattern.MatchString(name) {
+					continue
+				}
+				matched = true
+				if err := p.rule(value); err != nil {
+					AddPathError(validationErr, KeyPath(key), err)
+				}
+			}
+
+			if _, ok := declared[key]; schema.Strict && !ok && !matched {
+				validationErr.AddError(fmt.Sprintf(ErrMapExtraKey, FormatMapKey(key)))
+			}
+		}
+
+		for _, rule := range schema.Rules {
+			if err := rule(m); err != nil {
+				var nested *hvalid.ValidationError
+				if errors.As(err, &nested) && nested.Error() == err.Error() {
+					for _, msg := range nested.Errors {
+						validationErr.AddError(msg)
+					}
+					continue
+				}
+				validationErr.AddError(err.Error())
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
@@ -0,0 +1,375 @@
+package primitive
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrNumberNotGreater    = "must be greater than %v"
+	ErrNumberNotLess       = "must be less than %v"
+	ErrNumberZero          = "must not be zero"
+	ErrNumberNaN           = "must not be NaN"
+	ErrNumberInfinite      = "must be finite"
+	ErrNumberNotMultiple   = "must be a multiple of %v"
+	ErrNumberDecimalPlaces = "must have at most %d decimal places"
+	ErrNumberNotInteger    = "must be an integer"
+	ErrNumberNotApprox     = "must be approximately equal to %v"
+)
+
+// DefaultMultipleTolerance 浮点数 MultipleOf 默认的相对容差，按步长的倍数计算
+const DefaultMultipleTolerance = 1e-9
+
+// Number 数值类型
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// GreaterThan 验证数值大于 min（不含），min 为 NaN 时 panic
+func (v *NumberValidator[T]) GreaterThan(min T) hvalid.ValidatorFunc[T] {
+	mustNotNaN(min)
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if !(num > min) {
+			validationErr.AddError(fmt.Sprintf(ErrNumberNotGreater, min))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// LessThan 验证数值小于 max（不含），max 为 NaN 时 panic
+func (v *NumberValidator[T]) LessThan(max T) hvalid.ValidatorFunc[T] {
+	mustNotNaN(max)
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if !(num < max) {
+			validationErr.AddError(fmt.Sprintf(ErrNumberNotLess, max))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// NonZero 验证数值不为零
+func (v *NumberValidator[T]) NonZero() hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if num == 0 {
+			validationErr.AddError(ErrNumberZero)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// NotNaN 验证数值不是 NaN，整数类型总是通过
+func (v *NumberValidator[T]) NotNaN() hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if num != num {
+			validationErr.AddError(ErrNumberNaN)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// Finite 验证数值既不是 NaN 也不是无穷大，整数类型总是通过
+func (v *NumberValidator[T]) Finite() hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if f := float64(num); math.IsNaN(f) {
+			validationErr.AddError(ErrNumberNaN)
+			return validationErr
+		} else if math.IsInf(f, 0) {
+			validationErr.AddError(ErrNumberInfinite)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// Integer 验证数值是有限的整数值，例如 3.0 通过、3.5 不通过，整数类型总是通过
+func (v *NumberValidator[T]) Integer() hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if f := float64(num); math.IsInf(f, 0) || math.Trunc(f) != f {
+			validationErr.AddError(ErrNumberNotInteger)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// MultipleOf 验证数值是 step 的整数倍，浮点数使用 DefaultMultipleTolerance 作为相对容差，
+// 例如 0.3 视为 0.1 的倍数；step 不大于 0 或为 NaN 时 panic
+func (v *NumberValidator[T]) MultipleOf(step T) hvalid.ValidatorFunc[T] {
+	return v.MultipleOfWithin(step, math.Abs(float64(step))*DefaultMultipleTolerance)
+}
+
+// MultipleOfWithin 验证数值是 step 的整数倍，浮点数的余数绝对值不超过 tolerance 即可，整数类型忽略 tolerance；
+// step 不大于 0、为 NaN 或 tolerance 为负数时 panic
+func (v *NumberValidator[T]) MultipleOfWithin(step T, tolerance float64) hvalid.ValidatorFunc[T] {
+	if !(step > 0) {
+		panic(fmt.Sprintf("primitive: step must be positive, got %v", step))
+	}
+	if !(tolerance >= 0) {
+		panic(fmt.Sprintf("primitive: tolerance must not be negative, got %v", tolerance))
+	}
+
+	float := isFloatKind[T]()
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		var ok bool
+		if float {
+			remainder := math.Remainder(float64(num), float64(step))
+			ok = math.Abs(remainder) <= tolerance
+		} else {
+			ok = num-num/step*step == 0
+		}
+
+		if !ok {
+			validationErr.AddError(fmt.Sprintf(ErrNumberNotMultiple, step))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// MaxDecimalPlaces 验证浮点数的最短十进制表示最多有 places 位小数，例如 12.34 有 2 位，整数类型总是通过；
+// places 为负数时 panic
+func (v *NumberValidator[T]) MaxDecimalPlaces(places int) hvalid.ValidatorFunc[T] {
+	if places < 0 {
+		panic(fmt.Sprintf("primitive: decimal places must not be negative, got %d", places))
+	}
+
+	bits := 64
+	if reflect.TypeOf(*new(T)).Kind() == reflect.Float32 {
+		bits = 32
+	}
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		f := float64(num)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			validationErr.AddError(ErrNumberInfinite)
+			return validationErr
+		}
+
+		s := strconv.FormatFloat(f, 'f', -1, bits)
+		if i := strings.IndexByte(s, '.'); i >= 0 && len(s)-i-1 > places {
+			validationErr.AddError(fmt.Sprintf(ErrNumberDecimalPlaces, places))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// ApproxEqual 验证数值与 target 之差的绝对值不超过 epsilon；target 为 NaN 或 epsilon 为负数时 panic
+func (v *NumberValidator[T]) ApproxEqual(target T, epsilon float64) hvalid.ValidatorFunc[T] {
+	mustNotNaN(target)
+	if !(epsilon >= 0) {
+		panic(fmt.Sprintf("primitive: epsilon must not be negative, got %v", epsilon))
+	}
+
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if num != target && !(math.Abs(float64(num)-float64(target)) <= epsilon) {
+			validationErr.AddError(fmt.Sprintf(ErrNumberNotApprox, target))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// WithinULP 验证数值与 target 之间相差不超过 ulps 个可表示的浮点数（按数值自身的精度计算），
+// 整数类型要求相差不超过 ulps；target 为 NaN 时 panic
+func (v *NumberValidator[T]) WithinULP(target T, ulps uint64) hvalid.ValidatorFunc[T] {
+	mustNotNaN(target)
+
+	kind := reflect.TypeOf(target).Kind()
+	return hvalid.ValidatorFunc[T](func(num T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		var distance uint64
+		switch {
+		case num == target:
+			// 包括 +0 与 -0
+		case kind == reflect.Float32:
+			distance = ulpDistance(orderedBits32(float32(num)), orderedBits32(float32(target)))
+		case kind == reflect.Float64:
+			distance = ulpDistance(orderedBits64(float64(num)), orderedBits64(float64(target)))
+		default:
+			if num > target {
+				distance = uint64(num - target)
+			} else {
+				distance = uint64(target - num)
+			}
+		}
+
+		if num != num || distance > ulps {
+			validationErr.AddError(fmt.Sprintf(ErrNumberNotApprox, target))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// AtLeast 验证数值大于等于 min，min 可以是任意数值类型，无法精确表示为 T 时 panic，
+// 例如 AtLeast[uint8](300) 或 AtLeast[int](0.5)
+func AtLeast[T, B Number](min B) hvalid.ValidatorFunc[T] {
+	bound := mustConvertBound[T](min)
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		if !(value >= bound) {
+			return fmt.Errorf(ErrNumberTooSmall, bound)
+		}
+		return nil
+	})
+}
+
+// AtMost 验证数值小于等于 max，max 无法精确表示为 T 时 panic
+func AtMost[T, B Number](max B) hvalid.ValidatorFunc[T] {
+	bound := mustConvertBound[T](max)
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		if !(value <= bound) {
+			return fmt.Errorf(ErrNumberTooBig, bound)
+		}
+		return nil
+	})
+}
+
+// Above 验证数值大于 min（不含），min 无法精确表示为 T 时 panic
+func Above[T, B Number](min B) hvalid.ValidatorFunc[T] {
+	bound := mustConvertBound[T](min)
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		if !(value > bound) {
+			return fmt.Errorf(ErrNumberNotGreater, bound)
+		}
+		return nil
+	})
+}
+
+// Below 验证数值小于 max（不含），max 无法精确表示为 T 时 panic
+func Below[T, B Number](max B) hvalid.ValidatorFunc[T] {
+	bound := mustConvertBound[T](max)
+	return hvalid.ValidatorFunc[T](func(value T) error {
+		if !(value < bound) {
+			return fmt.Errorf(ErrNumberNotLess, bound)
+		}
+		return nil
+	})
+}
+
+// ConvertBound 将边界值精确转换为 T，超出范围、丢失精度或为 NaN 时返回错误
+func ConvertBound[T, B Number](bound B) (T, error) {
+	var zero T
+
+	src := reflect.ValueOf(bound)
+	exact := new(big.Float)
+	switch {
+	case src.CanInt():
+		exact.SetInt64(src.Int())
+	case src.CanUint():
+		exact.SetUint64(src.Uint())
+	default:
+		f := src.Float()
+		if math.IsNaN(f) {
+			return zero, fmt.Errorf("bound %v is NaN", bound)
+		}
+		exact.SetFloat64(f)
+	}
+
+	dst := reflect.New(reflect.TypeOf(zero)).Elem()
+	fail := fmt.Errorf("bound %v cannot be represented as %s", bound, dst.Type())
+	switch {
+	case dst.CanInt():
+		i, acc := exact.Int64()
+		if acc != big.Exact || !exact.IsInt() || dst.OverflowInt(i) {
+			return zero, fail
+		}
+		dst.SetInt(i)
+	case dst.CanUint():
+		u, acc := exact.Uint64()
+		if acc != big.Exact || !exact.IsInt() || dst.OverflowUint(u) {
+			return zero, fail
+		}
+		dst.SetUint(u)
+	case dst.Kind() == reflect.Float32:
+		f, acc := exact.Float32()
+		if acc != big.Exact {
+			return zero, fail
+		}
+		dst.SetFloat(float64(f))
+	default:
+		f, acc := exact.Float64()
+		if acc != big.Exact {
+			return zero, fail
+		}
+		dst.SetFloat(f)
+	}
+	return dst.Interface().(T), nil
+}
+
+// mustConvertBound 转换边界值，失败时 panic
+func mustConvertBound[T, B Number](bound B) T {
+	converted, err := ConvertBound[T](bound)
+	if err != nil {
+		panic("primitive: " + err.Error())
+	}
+	return converted
+}
+
+// mustNotNaN 边界值为 NaN 时 panic
+func mustNotNaN[T Number](bound T) {
+	if bound != bound {
+		panic("primitive: bound must not be NaN")
+	}
+}
+
+// isFloatKind T 是否为浮点类型
+func isFloatKind[T Number]() bool {
+	kind := reflect.TypeOf(*new(T)).Kind()
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// orderedBits64 将 float64 映射为按数值大小单调递增的整数，相邻浮点数相差 1
+func orderedBits64(f float64) uint64 {
+	bits := math.Float64bits(f)
+	if bits>>63 == 1 {
+		return ^bits
+	}
+	return bits | 1<<63
+}
+
+// orderedBits32 将 float32 映射为按数值大小单调递增的整数
+func orderedBits32(f float32) uint64 {
+	bits := math.Float32bits(f)
+	if bits>>31 == 1 {
+		return uint64(^bits)
+	}
+	return uint64(bits | 1<<31)
+}
+
+// ulpDistance 计算两个有序整数表示之间的距离
+func ulpDistance(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
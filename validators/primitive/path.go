@@ -0,0 +1,89 @@
+package primitive
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// AddPathError 将元素或键的验证错误加上路径后添加到 validationErr
+//
+// path 形如 element[2] 或 key["abc"]。err 为 *hvalid.ValidationError 时其中的每条错误单独添加，
+// 以 element[ 或 key[ 开头的嵌套路径直接拼接，例如 element[2][5]、key["abc"][0]。
+func AddPathError(validationErr *hvalid.ValidationError, path string, err error) {
+	var nested *hvalid.ValidationError
+	if !errors.As(err, &nested) || nested.Error() != err.Error() || !nested.HasError() {
+		validationErr.AddError(fmt.Sprintf("%s: %v", path, err))
+		return
+	}
+
+	for _, msg := range nested.Errors {
+		if rest, ok := strings.CutPrefix(msg, "element["); ok {
+			validationErr.AddError(path + "[" + rest)
+		} else if rest, ok := strings.CutPrefix(msg, "key["); ok {
+			validationErr.AddError(path + "[" + rest)
+		} else {
+			validationErr.AddError(fmt.Sprintf("%s: %s", path, msg))
+		}
+	}
+}
+
+// ElementPath 返回切片元素的路径，例如 element[2]
+func ElementPath(index int) string {
+	return fmt.Sprintf("element[%d]", index)
+}
+
+// KeyPath 返回 map 值的路径，例如 key["abc"]、key[10]
+func KeyPath(key any) string {
+	return "key[" + FormatMapKey(key) + "]"
+}
+
+// FormatMapKey 格式化 map 键，字符串键加引号
+func FormatMapKey(key any) string {
+	if rv := reflect.ValueOf(key); rv.Kind() == reflect.String {
+		return fmt.Sprintf("%q", rv.String())
+	}
+	return fmt.Sprint(key)
+}
+
+// SortedKeys 返回排序后的键，保证错误信息顺序稳定
+//
+// 数字键按数值排序，字符串键按字典序排序，false 排在 true 之前，其他类型按格式化后的文本排序。
+func SortedKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return lessKey(reflect.ValueOf(keys[i]), reflect.ValueOf(keys[j]))
+	})
+	return keys
+}
+
+// lessKey 比较两个键，动态类型不同时先按类型种类排序
+func lessKey(a, b reflect.Value) bool {
+	if a.Kind() != b.Kind() {
+		return a.Kind() < b.Kind()
+	}
+
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	case reflect.Invalid:
+		return false
+	default:
+		return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+	}
+}
@@ -0,0 +1,101 @@
+package primitive
+
+import (
+	"container/list"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+// DefaultRegexpCacheSize 默认正则表达式缓存容量
+const DefaultRegexpCacheSize = 256
+
+// defaultRegexpCache 包内共享的正则表达式缓存
+var defaultRegexpCache = NewRegexpCache(DefaultRegexpCacheSize)
+
+// RegexpCache 有容量上限的正则表达式编译缓存，超出容量时淘汰最久未使用的表达式，可并发使用
+type RegexpCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // 最近使用的在前
+	entries  map[string]*list.Element
+}
+
+// regexpEntry 缓存条目
+type regexpEntry struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+// NewRegexpCache 创建正则表达式缓存，capacity 小于 1 时按 1 处理
+func NewRegexpCache(capacity int) *RegexpCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &RegexpCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Compile 编译正则表达式，已编译过的表达式直接从缓存返回，编译失败的表达式不缓存
+func (c *RegexpCache) Compile(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	if elem, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(elem)
+		c.mu.Unlock()
+		return elem.Value.(*regexpEntry).re, nil
+	}
+	c.mu.Unlock()
+
+	// 编译在锁外进行，并发编译同一表达式时保留先写入的结果
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*regexpEntry).re, nil
+	}
+	c.entries[pattern] = c.order.PushFront(&regexpEntry{pattern: pattern, re: re})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*regexpEntry).pattern)
+	}
+	return re, nil
+}
+
+// Len 获取缓存的表达式数量
+func (c *RegexpCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Clear 清空缓存
+func (c *RegexpCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+// CompileRegexp 使用共享缓存编译正则表达式
+func CompileRegexp(pattern string) (*regexp.Regexp, error) {
+	return defaultRegexpCache.Compile(pattern)
+}
+
+// MustCompileRegexp 使用共享缓存编译正则表达式，表达式无效时 panic
+func MustCompileRegexp(pattern string) *regexp.Regexp {
+	re, err := CompileRegexp(pattern)
+	if err != nil {
+		panic("primitive: invalid pattern " + strconv.Quote(pattern) + ": " + err.Error())
+	}
+	return re
+}
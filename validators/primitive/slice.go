@@ -2,6 +2,7 @@ package primitive
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/lyonnee/hvalid"
 )
@@ -13,8 +14,24 @@ const (
 	ErrSliceEmpty    = "must not be empty"
 	ErrSliceNotEmpty = "must be empty"
 	ErrSliceContains = "must contain the element"
+
+	ErrSliceNotUnique     = "element[%d] duplicates element[%d]"
+	ErrSliceMissing       = "must contain element: %v"
+	ErrSliceMissingAny    = "must contain at least one of: %v"
+	ErrSliceExcluded      = "element[%d] must not be %v"
+	ErrSliceNotSorted     = "element[%d] is out of order"
+	ErrSliceNotIncreasing = "element[%d] must be strictly greater than element[%d]"
+	ErrSliceNotSubset     = "element[%d] is not allowed: %v"
+	ErrSliceCountTooFew   = "at least %d elements must match, got %d"
+	ErrSliceCountTooMany  = "at most %d elements may match, got %d"
+	ErrSliceCountNotEqual = "exactly %d elements must match, got %d"
 )
 
+// Ordered 可以用 < 比较的类型
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64 | ~string
+}
+
 // SliceValidator 切片验证器结构体
 type SliceValidator[T any] struct {
 	FieldName string // 字段名称
@@ -79,13 +96,13 @@ func (v *SliceValidator[T]) Empty() hvalid.ValidatorFunc[[]T] {
 	})
 }
 
-// Contains 验证是否包含指定元素
+// Contains 验证是否包含指定元素，元素使用 reflect.DeepEqual 比较
 func (v *SliceValidator[T]) Contains(element T) hvalid.ValidatorFunc[[]T] {
 	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
 		validationErr := hvalid.NewValidationError(v.FieldName)
 
 		for _, item := range slice {
-			if fmt.Sprintf("%v", item) == fmt.Sprintf("%v", element) {
+			if reflect.DeepEqual(item, element) {
 				return nil
 			}
 		}
@@ -93,3 +110,350 @@ func (v *SliceValidator[T]) Contains(element T) hvalid.ValidatorFunc[[]T] {
 		return validationErr
 	})
 }
+
+// ContainsFunc 使用自定义相等函数验证是否包含指定元素
+func (v *SliceValidator[T]) ContainsFunc(element T, equal func(a, b T) bool) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if indexFunc(slice, element, equal) < 0 {
+			validationErr.AddError(fmt.Sprintf(ErrSliceMissing, element))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// Each 验证每个元素，错误信息带有元素下标
+func (v *SliceValidator[T]) Each(rule hvalid.ValidatorFunc[T]) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for i, item := range slice {
+			if err := rule(item); err != nil {
+				AddPathError(validationErr, ElementPath(i), err)
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// UniqueFunc 使用自定义相等函数验证元素互不重复
+func (v *SliceValidator[T]) UniqueFunc(equal func(a, b T) bool) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for i := 1; i < len(slice); i++ {
+			if j := indexFunc(slice[:i], slice[i], equal); j >= 0 {
+				validationErr.AddError(fmt.Sprintf(ErrSliceNotUnique, i, j))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// ContainsAllFunc 使用自定义相等函数验证包含所有指定元素
+func (v *SliceValidator[T]) ContainsAllFunc(equal func(a, b T) bool, elements ...T) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for _, element := range elements {
+			if indexFunc(slice, element, equal) < 0 {
+				validationErr.AddError(fmt.Sprintf(ErrSliceMissing, element))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// ContainsAnyFunc 使用自定义相等函数验证至少包含一个指定元素
+func (v *SliceValidator[T]) ContainsAnyFunc(equal func(a, b T) bool, elements ...T) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for _, element := range elements {
+			if indexFunc(slice, element, equal) >= 0 {
+				return nil
+			}
+		}
+		validationErr.AddError(fmt.Sprintf(ErrSliceMissingAny, elements))
+		return validationErr
+	})
+}
+
+// ExcludesFunc 使用自定义相等函数验证不包含任何指定元素
+func (v *SliceValidator[T]) ExcludesFunc(equal func(a, b T) bool, elements ...T) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for i, item := range slice {
+			if indexFunc(elements, item, equal) >= 0 {
+				validationErr.AddError(fmt.Sprintf(ErrSliceExcluded, i, item))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// SubsetFunc 使用自定义相等函数验证所有元素都在 superset 中
+func (v *SliceValidator[T]) SubsetFunc(equal func(a, b T) bool, superset ...T) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for i, item := range slice {
+			if indexFunc(superset, item, equal) < 0 {
+				validationErr.AddError(fmt.Sprintf(ErrSliceNotSubset, i, item))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// SupersetFunc 使用自定义相等函数验证包含 subset 的所有元素
+func (v *SliceValidator[T]) SupersetFunc(equal func(a, b T) bool, subset ...T) hvalid.ValidatorFunc[[]T] {
+	return v.ContainsAllFunc(equal, subset...)
+}
+
+// SortedFunc 使用自定义排序函数验证元素按非递减顺序排列
+func (v *SliceValidator[T]) SortedFunc(less func(a, b T) bool) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for i := 1; i < len(slice); i++ {
+			if less(slice[i], slice[i-1]) {
+				validationErr.AddError(fmt.Sprintf(ErrSliceNotSorted, i))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// StrictlyIncreasingFunc 使用自定义排序函数验证元素严格递增
+func (v *SliceValidator[T]) StrictlyIncreasingFunc(less func(a, b T) bool) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for i := 1; i < len(slice); i++ {
+			if !less(slice[i-1], slice[i]) {
+				validationErr.AddError(fmt.Sprintf(ErrSliceNotIncreasing, i, i-1))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// CountAtLeast 验证满足条件的元素至少有 n 个
+func (v *SliceValidator[T]) CountAtLeast(pred func(T) bool, n int) hvalid.ValidatorFunc[[]T] {
+	return v.count(pred, func(count int) string {
+		if count < n {
+			return fmt.Sprintf(ErrSliceCountTooFew, n, count)
+		}
+		return ""
+	})
+}
+
+// CountAtMost 验证满足条件的元素至多有 n 个
+func (v *SliceValidator[T]) CountAtMost(pred func(T) bool, n int) hvalid.ValidatorFunc[[]T] {
+	return v.count(pred, func(count int) string {
+		if count > n {
+			return fmt.Sprintf(ErrSliceCountTooMany, n, count)
+		}
+		return ""
+	})
+}
+
+// CountExactly 验证满足条件的元素恰好有 n 个
+func (v *SliceValidator[T]) CountExactly(pred func(T) bool, n int) hvalid.ValidatorFunc[[]T] {
+	return v.count(pred, func(count int) string {
+		if count != n {
+			return fmt.Sprintf(ErrSliceCountNotEqual, n, count)
+		}
+		return ""
+	})
+}
+
+// count 统计满足条件的元素数量，check 返回非空字符串时验证失败
+func (v *SliceValidator[T]) count(pred func(T) bool, check func(count int) string) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if msg := check(CountWhere(slice, pred)); msg != "" {
+			validationErr.AddError(msg)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// CountWhere 统计满足条件的元素数量
+func CountWhere[T any](slice []T, pred func(T) bool) int {
+	count := 0
+	for _, item := range slice {
+		if pred(item) {
+			count++
+		}
+	}
+	return count
+}
+
+// Unique 验证元素互不重复
+func Unique[T comparable](fieldName string) hvalid.ValidatorFunc[[]T] {
+	return UniqueBy(fieldName, func(item T) T { return item })
+}
+
+// UniqueBy 验证按 key 计算的键互不重复
+func UniqueBy[T any, K comparable](fieldName string, key func(T) K) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(fieldName)
+
+		seen := make(map[K]int, len(slice))
+		for i, item := range slice {
+			k := key(item)
+			if j, ok := seen[k]; ok {
+				validationErr.AddError(fmt.Sprintf(ErrSliceNotUnique, i, j))
+				continue
+			}
+			seen[k] = i
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// ContainsAll 验证包含所有指定元素
+func ContainsAll[T comparable](fieldName string, elements ...T) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(fieldName)
+
+		set := toSet(slice)
+		for _, element := range elements {
+			if _, ok := set[element]; !ok {
+				validationErr.AddError(fmt.Sprintf(ErrSliceMissing, element))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// ContainsAny 验证至少包含一个指定元素
+func ContainsAny[T comparable](fieldName string, elements ...T) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(fieldName)
+
+		set := toSet(slice)
+		for _, element := range elements {
+			if _, ok := set[element]; ok {
+				return nil
+			}
+		}
+		validationErr.AddError(fmt.Sprintf(ErrSliceMissingAny, elements))
+		return validationErr
+	})
+}
+
+// Excludes 验证不包含任何指定元素
+func Excludes[T comparable](fieldName string, elements ...T) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(fieldName)
+
+		set := toSet(elements)
+		for i, item := range slice {
+			if _, ok := set[item]; ok {
+				validationErr.AddError(fmt.Sprintf(ErrSliceExcluded, i, item))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// Subset 验证所有元素都在 superset 中
+func Subset[T comparable](fieldName string, superset ...T) hvalid.ValidatorFunc[[]T] {
+	return hvalid.ValidatorFunc[[]T](func(slice []T) error {
+		validationErr := hvalid.NewValidationError(fieldName)
+
+		set := toSet(superset)
+		for i, item := range slice {
+			if _, ok := set[item]; !ok {
+				validationErr.AddError(fmt.Sprintf(ErrSliceNotSubset, i, item))
+			}
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// Superset 验证包含 subset 的所有元素
+func Superset[T comparable](fieldName string, subset ...T) hvalid.ValidatorFunc[[]T] {
+	return ContainsAll(fieldName, subset...)
+}
+
+// Sorted 验证元素按非递减顺序排列
+func Sorted[T Ordered](fieldName string) hvalid.ValidatorFunc[[]T] {
+	return NewSliceValidator[T](fieldName).SortedFunc(func(a, b T) bool { return a < b })
+}
+
+// StrictlyIncreasing 验证元素严格递增
+func StrictlyIncreasing[T Ordered](fieldName string) hvalid.ValidatorFunc[[]T] {
+	return NewSliceValidator[T](fieldName).StrictlyIncreasingFunc(func(a, b T) bool { return a < b })
+}
+
+// indexFunc 查找第一个与 element 相等的元素下标
+func indexFunc[T any](slice []T, element T, equal func(a, b T) bool) int {
+	for i, item := range slice {
+		if equal(item, element) {
+			return i
+		}
+	}
+	return -1
+}
+
+// toSet 将切片转换为集合
+func toSet[T comparable](slice []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(slice))
+	for _, item := range slice {
+		set[item] = struct{}{}
+	}
+	return set
+}
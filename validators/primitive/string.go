@@ -1,6 +1,7 @@
 package primitive
 
 import (
+	"fmt"
 	"net/url"
 	"regexp"
 	"strconv"
@@ -17,8 +18,16 @@ const (
 	ErrNotURL            = "must be a valid URL"
 	ErrNotEmail          = "must be a valid email address"
 	ErrNotMatchPattern   = "must match the required pattern"
+	ErrStringNoPrefix    = "must start with %q"
+	ErrStringNoSuffix    = "must end with %q"
+	ErrStringNotOneOf    = "must be one of %v"
+	ErrStringNotLower    = "must be lowercase"
+	ErrStringNotUpper    = "must be uppercase"
 )
 
+// emailPattern IsEmail 使用的邮箱格式
+var emailPattern = regexp.MustCompile(`^([\w\.\_\-]{2,10})@(\w{1,}).([a-z]{2,4})$`)
+
 // StringValidator 字符串验证器结构体
 type StringValidator struct {
 	FieldName string // 字段名称
@@ -101,8 +110,7 @@ func (v *StringValidator) IsEmail() hvalid.ValidatorFunc[string] {
 	return hvalid.ValidatorFunc[string](func(field string) error {
 		validationErr := hvalid.NewValidationError(v.FieldName)
 
-		result, _ := regexp.MatchString(`^([\w\.\_\-]{2,10})@(\w{1,}).([a-z]{2,4})$`, field)
-		if !result {
+		if !emailPattern.MatchString(field) {
 			validationErr.AddError(ErrNotEmail)
 			return validationErr
 		}
@@ -110,13 +118,19 @@ func (v *StringValidator) IsEmail() hvalid.ValidatorFunc[string] {
 	})
 }
 
-// Regexp 使用正则表达式验证
+// Regexp 使用正则表达式验证，表达式通过共享缓存编译，无效时在创建验证函数时 panic
+//
+// 表达式来自外部输入时，应先使用 CompileRegexp 检查错误，再通过 MatchRegexp 创建验证函数。
 func (v *StringValidator) Regexp(pattern string) hvalid.ValidatorFunc[string] {
+	return v.MatchRegexp(MustCompileRegexp(pattern))
+}
+
+// MatchRegexp 使用已编译的正则表达式验证
+func (v *StringValidator) MatchRegexp(re *regexp.Regexp) hvalid.ValidatorFunc[string] {
 	return hvalid.ValidatorFunc[string](func(field string) error {
 		validationErr := hvalid.NewValidationError(v.FieldName)
 
-		result, _ := regexp.MatchString(pattern, field)
-		if !result {
+		if !re.MatchString(field) {
 			validationErr.AddError(ErrNotMatchPattern)
 			return validationErr
 		}
@@ -124,6 +138,91 @@ func (v *StringValidator) Regexp(pattern string) hvalid.ValidatorFunc[string] {
 	})
 }
 
+// HasPrefix 验证字符串以指定前缀开头
+func (v *StringValidator) HasPrefix(prefix string) hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if !strings.HasPrefix(field, prefix) {
+			validationErr.AddError(fmt.Sprintf(ErrStringNoPrefix, prefix))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// HasSuffix 验证字符串以指定后缀结尾
+func (v *StringValidator) HasSuffix(suffix string) hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if !strings.HasSuffix(field, suffix) {
+			validationErr.AddError(fmt.Sprintf(ErrStringNoSuffix, suffix))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// OneOf 验证字符串是允许的值之一
+func (v *StringValidator) OneOf(values ...string) hvalid.ValidatorFunc[string] {
+	allowed := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		allowed[value] = struct{}{}
+	}
+
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if _, ok := allowed[field]; !ok {
+			validationErr.AddError(fmt.Sprintf(ErrStringNotOneOf, values))
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// OneOfFold 验证字符串是允许的值之一，忽略大小写
+func (v *StringValidator) OneOfFold(values ...string) hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		for _, value := range values {
+			if strings.EqualFold(field, value) {
+				return nil
+			}
+		}
+		validationErr.AddError(fmt.Sprintf(ErrStringNotOneOf, values))
+		return validationErr
+	})
+}
+
+// Lowercase 验证字符串不包含大写字母
+func (v *StringValidator) Lowercase() hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if strings.ToLower(field) != field {
+			validationErr.AddError(ErrStringNotLower)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// Uppercase 验证字符串不包含小写字母
+func (v *StringValidator) Uppercase() hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if strings.ToUpper(field) != field {
+			validationErr.AddError(ErrStringNotUpper)
+			return validationErr
+		}
+		return nil
+	})
+}
+
 // checkIPv4 检查是否为有效的IPv4地址
 func checkIPv4(IP string) bool {
 	strs := strings.Split(IP, ".")
@@ -0,0 +1,201 @@
+package primitive
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrStringInvisible    = "must not contain invisible character %U at %d"
+	ErrStringBidiControl  = "must not contain bidi control character %U at %d"
+	ErrStringMixedScript  = "must not mix scripts: %s character %q at %d conflicts with %s"
+	ErrStringConfusable   = "is confusable with %q"
+	ErrStringConfusableAt = "confusable character %q at %d"
+)
+
+// scriptNames 按名称排序的 Unicode 文字列表，保证查找结果稳定
+var scriptNames = func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// NoInvisible 验证不包含不可见字符（格式字符、默认可忽略字符和变体选择符），报告每个字符的码点位置
+func (v *StringValidator) NoInvisible() hvalid.ValidatorFunc[string] {
+	return v.eachOffending(ErrStringInvisible, isInvisible)
+}
+
+// NoBidiControl 验证不包含双向文本控制字符，例如 RLO（U+202E），报告每个字符的码点位置
+func (v *StringValidator) NoBidiControl() hvalid.ValidatorFunc[string] {
+	return v.eachOffending(ErrStringBidiControl, isBidiControl)
+}
+
+// NoMixedScript 验证字符串只使用一种文字，Common 和 Inherited 字符（数字、标点、组合符）不计入
+//
+// 按 UTS #39 的增强文字集合判断，汉字与平假名、片假名（日文），汉字与谚文（韩文），
+// 汉字与注音符号（中文）的组合视为单一文字。不处理 Script_Extensions 属性。
+func (v *StringValidator) NoMixedScript() hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		var resolved []string
+		first := ""
+		offset := 0
+		for _, r := range field {
+			script := scriptOf(r)
+			if script == "" || script == "Common" || script == "Inherited" {
+				offset++
+				continue
+			}
+
+			augmented := augmentedScripts(script)
+			if resolved == nil {
+				resolved, first = augmented, script
+			} else if resolved = intersectScripts(resolved, augmented); len(resolved) == 0 {
+				validationErr.AddError(fmt.Sprintf(ErrStringMixedScript, script, r, offset, first))
+				return validationErr
+			}
+			offset++
+		}
+		return nil
+	})
+}
+
+// NotConfusableWith 验证字符串与受保护的字符串不构成仿冒，即骨架相同但内容不同
+//
+// 骨架使用内置的 confusables 子集计算，报告每个仿冒字符的码点位置。
+func (v *StringValidator) NotConfusableWith(protected ...string) hvalid.ValidatorFunc[string] {
+	skeletons := make([]string, len(protected))
+	for i, p := range protected {
+		skeletons[i] = Skeleton(p)
+	}
+
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		skeleton := Skeleton(field)
+		for i, p := range protected {
+			if field == p || skeleton != skeletons[i] {
+				continue
+			}
+
+			validationErr.AddError(fmt.Sprintf(ErrStringConfusable, p))
+			offset := 0
+			for _, r := range field {
+				if _, mapped := confusables[r]; (mapped || isInvisible(r)) && !strings.ContainsRune(p, r) {
+					validationErr.AddError(fmt.Sprintf(ErrStringConfusableAt, r, offset))
+				}
+				offset++
+			}
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// Skeleton 计算字符串的仿冒骨架，骨架相同的字符串在视觉上容易混淆
+//
+// 按 UTS #39 的方式去除默认可忽略字符并将每个字符映射为原型字符，
+// 映射表是 confusables.txt 的子集，且不做 NFD 规范化。
+func Skeleton(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if isInvisible(r) {
+			continue
+		}
+		if prototype, ok := confusables[r]; ok {
+			b.WriteString(prototype)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// eachOffending 对每个不符合要求的字符记录一条错误
+func (v *StringValidator) eachOffending(message string, offending func(rune) bool) hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		offset := 0
+		for _, r := range field {
+			if offending(r) {
+				validationErr.AddError(fmt.Sprintf(message, r, offset))
+			}
+			offset++
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// isInvisible 是否为不可见字符
+func isInvisible(r rune) bool {
+	return r == 0x034f || unicode.In(r, unicode.Cf, unicode.Other_Default_Ignorable_Code_Point, unicode.Variation_Selector)
+}
+
+// isBidiControl 是否为双向文本控制字符
+func isBidiControl(r rune) bool {
+	switch {
+	case r == 0x061c, r == 0x200e, r == 0x200f:
+		return true
+	case r >= 0x202a && r <= 0x202e:
+		return true
+	case r >= 0x2066 && r <= 0x2069:
+		return true
+	default:
+		return false
+	}
+}
+
+// scriptOf 获取字符所属的文字，未分配的字符返回空字符串
+func scriptOf(r rune) string {
+	for _, name := range scriptNames {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// augmentedScripts 获取文字的增强集合，Jpan、Kore、Hanb 分别表示日文、韩文和中文
+func augmentedScripts(script string) []string {
+	switch script {
+	case "Han":
+		return []string{"Han", "Hanb", "Jpan", "Kore"}
+	case "Hiragana", "Katakana":
+		return []string{"Jpan"}
+	case "Hangul":
+		return []string{"Kore"}
+	case "Bopomofo":
+		return []string{"Hanb"}
+	default:
+		return []string{script}
+	}
+}
+
+// intersectScripts 计算两个文字集合的交集
+func intersectScripts(a, b []string) []string {
+	var result []string
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				result = append(result, x)
+				break
+			}
+		}
+	}
+	return result
+}
@@ -1,18 +1,52 @@
 package primitive
 
 import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
 	"github.com/lyonnee/hvalid"
 )
 
 // 预定义错误信息
 const (
-	ErrTextTooShort = "value length too short"
-	ErrTextTooLong  = "value length too long"
+	ErrTextTooShort        = "value length too short"
+	ErrTextTooLong         = "value length too long"
+	ErrTextNotASCII        = "must contain only ASCII characters, found %q at %d"
+	ErrTextNotPrintable    = "must contain only printable characters, found %q at %d"
+	ErrTextHasControl      = "must not contain control characters, found %q at %d"
+	ErrTextNotAlphanumeric = "must contain only letters and digits of the allowed scripts, found %q at %d"
+	ErrTextNotUTF8         = "must be valid UTF-8"
 )
 
+// LengthMode 文本长度的计算方式
+type LengthMode int
+
+const (
+	// LengthBytes 按字节计算
+	LengthBytes LengthMode = iota
+	// LengthRunes 按 Unicode 码点计算
+	LengthRunes
+	// LengthGraphemes 按用户感知的字符（扩展字素簇）计算，例如 "👨‍👩‍👧" 和 "é"（e + 组合符）都计为 1
+	LengthGraphemes
+)
+
+// TextLength 按指定方式计算文本长度
+func TextLength[T string | []byte](field T, mode LengthMode) int {
+	switch mode {
+	case LengthRunes:
+		return utf8.RuneCountInString(string(field))
+	case LengthGraphemes:
+		return graphemeCount(string(field))
+	default:
+		return len(field)
+	}
+}
+
 // TextValidator 文本验证器结构体
 type TextValidator[T string | []byte] struct {
-	FieldName string // 字段名称
+	FieldName string     // 字段名称
+	Mode      LengthMode // 长度计算方式，默认按字节
 }
 
 // NewTextValidator 创建文本验证器
@@ -22,12 +56,20 @@ func NewTextValidator[T string | []byte](fieldName string) *TextValidator[T] {
 	}
 }
 
+// NewTextValidatorWithMode 创建使用指定长度计算方式的文本验证器
+func NewTextValidatorWithMode[T string | []byte](fieldName string, mode LengthMode) *TextValidator[T] {
+	return &TextValidator[T]{
+		FieldName: fieldName,
+		Mode:      mode,
+	}
+}
+
 // MinLen 验证最小长度
 func (v *TextValidator[T]) MinLen(minLen int) hvalid.ValidatorFunc[T] {
 	return hvalid.ValidatorFunc[T](func(field T) error {
 		validationErr := hvalid.NewValidationError(v.FieldName)
 
-		l := len(field)
+		l := TextLength(field, v.Mode)
 		if l < minLen {
 			validationErr.AddError(ErrTextTooShort)
 			return validationErr
@@ -41,7 +83,7 @@ func (v *TextValidator[T]) MaxLen(maxLen int) hvalid.ValidatorFunc[T] {
 	return hvalid.ValidatorFunc[T](func(field T) error {
 		validationErr := hvalid.NewValidationError(v.FieldName)
 
-		l := len(field)
+		l := TextLength(field, v.Mode)
 		if l > maxLen {
 			validationErr.AddError(ErrTextTooLong)
 			return validationErr
@@ -49,3 +91,80 @@ func (v *TextValidator[T]) MaxLen(maxLen int) hvalid.ValidatorFunc[T] {
 		return nil
 	})
 }
+
+// ValidUTF8 验证是否为有效的 UTF-8 编码
+func (v *TextValidator[T]) ValidUTF8() hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(field T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if !utf8.ValidString(string(field)) {
+			validationErr.AddError(ErrTextNotUTF8)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// ASCII 验证只包含 ASCII 字符
+func (v *TextValidator[T]) ASCII() hvalid.ValidatorFunc[T] {
+	return v.eachRune(ErrTextNotASCII, func(r rune) bool {
+		return r <= unicode.MaxASCII
+	})
+}
+
+// Printable 验证只包含可打印字符，空格视为可打印字符
+//
+// 无效的 UTF-8 字节不可打印；文本中真实存在的替换字符 U+FFFD 是可打印字符。
+func (v *TextValidator[T]) Printable() hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(field T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		s := string(field)
+		for offset := 0; s != ""; offset++ {
+			r, size := utf8.DecodeRuneInString(s)
+			if (r == utf8.RuneError && size == 1) || !unicode.IsPrint(r) {
+				validationErr.AddError(fmt.Sprintf(ErrTextNotPrintable, r, offset))
+				return validationErr
+			}
+			s = s[size:]
+		}
+		return nil
+	})
+}
+
+// NoControl 验证不包含控制字符
+func (v *TextValidator[T]) NoControl() hvalid.ValidatorFunc[T] {
+	return v.eachRune(ErrTextHasControl, func(r rune) bool {
+		return !unicode.IsControl(r)
+	})
+}
+
+// Alphanumeric 验证只包含字母和数字，指定 scripts（如 unicode.Han、unicode.Latin）时字母必须属于其中之一
+func (v *TextValidator[T]) Alphanumeric(scripts ...*unicode.RangeTable) hvalid.ValidatorFunc[T] {
+	return v.eachRune(ErrTextNotAlphanumeric, func(r rune) bool {
+		if unicode.IsDigit(r) {
+			return true
+		}
+		if !unicode.IsLetter(r) && !unicode.IsMark(r) {
+			return false
+		}
+		return len(scripts) == 0 || unicode.In(r, scripts...)
+	})
+}
+
+// eachRune 验证每个字符都满足条件，错误信息包含第一个不满足条件的字符及其码点位置
+func (v *TextValidator[T]) eachRune(message string, ok func(rune) bool) hvalid.ValidatorFunc[T] {
+	return hvalid.ValidatorFunc[T](func(field T) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		offset := 0
+		for _, r := range string(field) {
+			if !ok(r) {
+				validationErr.AddError(fmt.Sprintf(message, r, offset))
+				return validationErr
+			}
+			offset++
+		}
+		return nil
+	})
+}
@@ -17,6 +17,7 @@ const (
 // TimeValidator 时间验证器结构体
 type TimeValidator struct {
 	FieldName string // 字段名称
+	Clock     Clock  // 相对时间规则使用的时钟，为 nil 时使用系统时钟
 }
 
 // NewTimeValidator 创建时间验证器
@@ -26,6 +27,14 @@ func NewTimeValidator(fieldName string) *TimeValidator {
 	}
 }
 
+// NewTimeValidatorWithClock 创建使用指定时钟的时间验证器
+func NewTimeValidatorWithClock(fieldName string, clock Clock) *TimeValidator {
+	return &TimeValidator{
+		FieldName: fieldName,
+		Clock:     clock,
+	}
+}
+
 // Before 验证是否在指定时间之前
 func (v *TimeValidator) Before(t time.Time) hvalid.ValidatorFunc[time.Time] {
 	return hvalid.ValidatorFunc[time.Time](func(value time.Time) error {
@@ -0,0 +1,259 @@
+package primitive
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrTimeNotWithinLast  = "must be within the last %v"
+	ErrTimeNotWithinNext  = "must be within the next %v"
+	ErrTimeInFuture       = "must not be in the future"
+	ErrTimeInPast         = "must not be in the past"
+	ErrTimeMinAge         = "must be at least %d years ago"
+	ErrTimeWeekday        = "must not be on %s"
+	ErrTimeBusinessHours  = "must be between %s and %s in %s"
+	ErrTimeMonth          = "month must be one of %v"
+	ErrTimeNoLocation     = "must have an explicit time zone"
+	ErrTimeLocation       = "must be in time zone %s"
+	ErrTimeNotTruncated   = "must be truncated to %v"
+	ErrTimeRangeOrder     = "start must be before end"
+	ErrTimeRangeSpan      = "span must be at most %v"
+	ErrTimeRangeZeroValue = "start and end must be set"
+)
+
+// Clock 时钟接口，便于在测试中固定当前时间
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock 使用系统时间的时钟
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SystemClock 系统时钟
+var SystemClock Clock = systemClock{}
+
+// TimeRange 起止时间
+type TimeRange struct {
+	Start time.Time // 开始时间
+	End   time.Time // 结束时间
+}
+
+// now 获取当前时间
+func (v *TimeValidator) now() time.Time {
+	if v.Clock == nil {
+		return SystemClock.Now()
+	}
+	return v.Clock.Now()
+}
+
+// WithinLast 验证时间在过去 d 之内，即 [now-d, now]
+func (v *TimeValidator) WithinLast(d time.Duration) hvalid.ValidatorFunc[time.Time] {
+	return v.rule(func(value time.Time) string {
+		now := v.now()
+		if value.Before(now.Add(-d)) || value.After(now) {
+			return fmt.Sprintf(ErrTimeNotWithinLast, d)
+		}
+		return ""
+	})
+}
+
+// WithinNext 验证时间在未来 d 之内，即 [now, now+d]
+func (v *TimeValidator) WithinNext(d time.Duration) hvalid.ValidatorFunc[time.Time] {
+	return v.rule(func(value time.Time) string {
+		now := v.now()
+		if value.Before(now) || value.After(now.Add(d)) {
+			return fmt.Sprintf(ErrTimeNotWithinNext, d)
+		}
+		return ""
+	})
+}
+
+// NotInFuture 验证时间不晚于当前时间
+func (v *TimeValidator) NotInFuture() hvalid.ValidatorFunc[time.Time] {
+	return v.rule(func(value time.Time) string {
+		if value.After(v.now()) {
+			return ErrTimeInFuture
+		}
+		return ""
+	})
+}
+
+// NotInPast 验证时间不早于当前时间
+func (v *TimeValidator) NotInPast() hvalid.ValidatorFunc[time.Time] {
+	return v.rule(func(value time.Time) string {
+		if value.Before(v.now()) {
+			return ErrTimeInPast
+		}
+		return ""
+	})
+}
+
+// MinAge 验证出生时间距今至少 years 周岁，按出生时间所在时区的日历计算，2 月 29 日出生的人在平年的 3 月 1 日满岁
+func (v *TimeValidator) MinAge(years int) hvalid.ValidatorFunc[time.Time] {
+	return v.rule(func(birth time.Time) string {
+		now := v.now().In(birth.Location())
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, birth.Location())
+		birthday := time.Date(birth.Year()+years, birth.Month(), birth.Day(), 0, 0, 0, 0, birth.Location())
+		if birthday.After(today) {
+			return fmt.Sprintf(ErrTimeMinAge, years)
+		}
+		return ""
+	})
+}
+
+// Weekday 验证时间在其所在时区是周一至周五
+func (v *TimeValidator) Weekday() hvalid.ValidatorFunc[time.Time] {
+	return v.OnWeekdays(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+}
+
+// OnWeekdays 验证时间在其所在时区是指定的星期几之一
+func (v *TimeValidator) OnWeekdays(days ...time.Weekday) hvalid.ValidatorFunc[time.Time] {
+	var allowed [7]bool
+	for _, day := range days {
+		allowed[day] = true
+	}
+
+	return v.rule(func(value time.Time) string {
+		if day := value.Weekday(); !allowed[day] {
+			return fmt.Sprintf(ErrTimeWeekday, day)
+		}
+		return ""
+	})
+}
+
+// BusinessHours 验证时间换算到 loc 后的钟点在 [open, close) 内，open、close 为距零点的时长，例如 9*time.Hour；
+// loc 为 nil 或 open 不早于 close 时 panic
+func (v *TimeValidator) BusinessHours(loc *time.Location, open, close time.Duration) hvalid.ValidatorFunc[time.Time] {
+	if loc == nil {
+		panic("primitive: location must not be nil")
+	}
+	if open < 0 || close > 24*time.Hour || open >= close {
+		panic(fmt.Sprintf("primitive: invalid business hours %v-%v", open, close))
+	}
+
+	return v.rule(func(value time.Time) string {
+		local := value.In(loc)
+		// 按钟点计算，不受夏令时切换当天的时长变化影响
+		clock := time.Duration(local.Hour())*time.Hour +
+			time.Duration(local.Minute())*time.Minute +
+			time.Duration(local.Second())*time.Second +
+			time.Duration(local.Nanosecond())
+		if clock < open || clock >= close {
+			return fmt.Sprintf(ErrTimeBusinessHours, formatClock(open), formatClock(close), loc)
+		}
+		return ""
+	})
+}
+
+// InMonths 验证时间在其所在时区属于指定的月份之一
+func (v *TimeValidator) InMonths(months ...time.Month) hvalid.ValidatorFunc[time.Time] {
+	var allowed [13]bool
+	for _, month := range months {
+		if month >= time.January && month <= time.December {
+			allowed[month] = true
+		}
+	}
+
+	return v.rule(func(value time.Time) string {
+		if !allowed[value.Month()] {
+			return fmt.Sprintf(ErrTimeMonth, months)
+		}
+		return ""
+	})
+}
+
+// HasLocation 验证时间带有明确的时区，即不是 time.Local
+//
+// 注意 time.Parse 在输入不含时区时返回 UTC，无法与显式的 UTC 区分，应使用 time.ParseInLocation 或含偏移的格式。
+func (v *TimeValidator) HasLocation() hvalid.ValidatorFunc[time.Time] {
+	return v.rule(func(value time.Time) string {
+		if value.Location() == time.Local {
+			return ErrTimeNoLocation
+		}
+		return ""
+	})
+}
+
+// InLocation 验证时间的时区为 loc，按时区名称比较；loc 为 nil 时 panic
+func (v *TimeValidator) InLocation(loc *time.Location) hvalid.ValidatorFunc[time.Time] {
+	if loc == nil {
+		panic("primitive: location must not be nil")
+	}
+
+	return v.rule(func(value time.Time) string {
+		if value.Location().String() != loc.String() {
+			return fmt.Sprintf(ErrTimeLocation, loc)
+		}
+		return ""
+	})
+}
+
+// Truncated 验证时间没有小于 d 的部分，例如 time.Second 要求没有纳秒、time.Minute 要求秒和纳秒都为 0；
+// d 不大于 0 时 panic
+//
+// d 不超过 24 小时时按时间所在时区的当天时刻判断，例如 time.Hour 接受 +05:30 时区的 10:00；
+// 更大的 d 按自零时刻（UTC）起的绝对时间判断，与 time.Time.Truncate 一致。
+func (v *TimeValidator) Truncated(d time.Duration) hvalid.ValidatorFunc[time.Time] {
+	if d <= 0 {
+		panic(fmt.Sprintf("primitive: truncation must be positive, got %v", d))
+	}
+
+	return v.rule(func(value time.Time) string {
+		truncated := value.Truncate(d).Equal(value)
+		if d <= 24*time.Hour {
+			hour, min, sec := value.Clock()
+			sinceMidnight := time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+				time.Duration(sec)*time.Second + time.Duration(value.Nanosecond())
+			truncated = sinceMidnight%d == 0
+		}
+		if !truncated {
+			return fmt.Sprintf(ErrTimeNotTruncated, d)
+		}
+		return ""
+	})
+}
+
+// Range 验证起止时间：都不为零值、开始早于结束，maxSpan 大于 0 时时长不超过 maxSpan
+func (v *TimeValidator) Range(maxSpan time.Duration) hvalid.ValidatorFunc[TimeRange] {
+	return hvalid.ValidatorFunc[TimeRange](func(r TimeRange) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		switch {
+		case r.Start.IsZero() || r.End.IsZero():
+			validationErr.AddError(ErrTimeRangeZeroValue)
+		case !r.Start.Before(r.End):
+			validationErr.AddError(ErrTimeRangeOrder)
+		case maxSpan > 0 && r.End.Sub(r.Start) > maxSpan:
+			validationErr.AddError(fmt.Sprintf(ErrTimeRangeSpan, maxSpan))
+		}
+
+		if validationErr.HasError() {
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// rule 将检查函数包装为验证函数，检查函数返回空字符串表示通过
+func (v *TimeValidator) rule(check func(time.Time) string) hvalid.ValidatorFunc[time.Time] {
+	return hvalid.ValidatorFunc[time.Time](func(value time.Time) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if message := check(value); message != "" {
+			validationErr.AddError(message)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// formatClock 将距零点的时长格式化为 hh:mm
+func formatClock(offset time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(offset/time.Hour), int(offset%time.Hour/time.Minute))
+}
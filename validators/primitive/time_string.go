@@ -0,0 +1,167 @@
+package primitive
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lyonnee/hvalid"
+)
+
+// 预定义错误信息
+const (
+	ErrTimeStringRFC3339     = "must be an RFC 3339 timestamp"
+	ErrTimeStringISODate     = "must be an ISO 8601 date"
+	ErrTimeStringISO8601     = "must be an ISO 8601 date or date-time"
+	ErrTimeStringLayout      = "must match layout %s"
+	ErrTimeStringUnix        = "must be a Unix timestamp in %s"
+	ErrTimeStringDuration    = "must be a duration such as 1h30m"
+	ErrTimeStringISODuration = "must be an ISO 8601 duration such as P3DT4H"
+)
+
+// TimeStringValidator 时间字符串验证器结构体，只验证能否解析；
+// 需要继续验证解析结果时，使用 complex 包中返回 time.Time 的解析管道
+type TimeStringValidator struct {
+	FieldName string // 字段名称
+}
+
+// NewTimeStringValidator 创建时间字符串验证器
+func NewTimeStringValidator(fieldName string) *TimeStringValidator {
+	return &TimeStringValidator{
+		FieldName: fieldName,
+	}
+}
+
+// RFC3339 验证是 RFC 3339 时间戳，例如 2025-10-19T08:30:00+08:00，允许小数秒
+func (v *TimeStringValidator) RFC3339() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrTimeStringRFC3339, func(s string) error {
+		_, err := time.Parse(time.RFC3339, s)
+		return err
+	})
+}
+
+// ISO8601Date 验证是 ISO 8601 日历日期、周日期或序数日期
+func (v *TimeStringValidator) ISO8601Date() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrTimeStringISODate, func(s string) error {
+		_, err := ParseISO8601Date(s)
+		return err
+	})
+}
+
+// ISO8601 验证是 ISO 8601 日期或日期时间
+func (v *TimeStringValidator) ISO8601() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrTimeStringISO8601, func(s string) error {
+		_, err := ParseISO8601(s)
+		return err
+	})
+}
+
+// Layout 验证符合 time.Parse 的自定义格式，例如 "2006/01/02 15:04"
+func (v *TimeStringValidator) Layout(layout string) hvalid.ValidatorFunc[string] {
+	return v.rule(fmt.Sprintf(ErrTimeStringLayout, layout), func(s string) error {
+		_, err := time.Parse(layout, s)
+		return err
+	})
+}
+
+// UnixEpoch 验证是以 unit 为单位的 Unix 时间戳，unit 不合法时 panic，参见 ParseUnixEpoch
+func (v *TimeStringValidator) UnixEpoch(unit time.Duration) hvalid.ValidatorFunc[string] {
+	MustEpochUnit(unit)
+	return v.rule(fmt.Sprintf(ErrTimeStringUnix, epochUnitName(unit)), func(s string) error {
+		_, err := ParseUnixEpoch(s, unit)
+		return err
+	})
+}
+
+// Duration 验证是 time.ParseDuration 能解析的时长，例如 "1h30m"、"-1.5s"
+func (v *TimeStringValidator) Duration() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrTimeStringDuration, func(s string) error {
+		_, err := time.ParseDuration(s)
+		return err
+	})
+}
+
+// ISODuration 验证是 ISO 8601 时长，例如 "P3DT4H"
+func (v *TimeStringValidator) ISODuration() hvalid.ValidatorFunc[string] {
+	return v.rule(ErrTimeStringISODuration, func(s string) error {
+		_, err := ParseISODuration(s)
+		return err
+	})
+}
+
+// ParseUnixEpoch 解析 Unix 时间戳字符串，返回 UTC 时间
+//
+// unit 为 time.Second、time.Millisecond、time.Microsecond 或 time.Nanosecond，其他值 panic；
+// 以秒为单位时允许最多 9 位小数，例如 "1700000000.25"。
+func ParseUnixEpoch(s string, unit time.Duration) (time.Time, error) {
+	MustEpochUnit(unit)
+	invalid := fmt.Errorf("invalid Unix timestamp %q", s)
+
+	digits, negative := strings.CutPrefix(s, "-")
+	whole, fraction, hasPoint := strings.Cut(digits, ".")
+	if len(whole) > 18 || !allDigits(whole) {
+		return time.Time{}, invalid
+	}
+	if hasPoint && (unit != time.Second || len(fraction) > 9 || !allDigits(fraction)) {
+		return time.Time{}, invalid
+	}
+
+	n := int64(atoiDigits(whole))
+	var nanos int64
+	for i := 0; i < 9; i++ {
+		nanos *= 10
+		if i < len(fraction) {
+			nanos += int64(fraction[i] - '0')
+		}
+	}
+	if negative {
+		n, nanos = -n, -nanos
+	}
+
+	switch unit {
+	case time.Second:
+		return time.Unix(n, nanos).UTC(), nil
+	case time.Millisecond:
+		return time.UnixMilli(n).UTC(), nil
+	case time.Microsecond:
+		return time.UnixMicro(n).UTC(), nil
+	default:
+		return time.Unix(0, n).UTC(), nil
+	}
+}
+
+// rule 执行解析函数，解析失败时报告 message
+func (v *TimeStringValidator) rule(message string, parse func(string) error) hvalid.ValidatorFunc[string] {
+	return hvalid.ValidatorFunc[string](func(field string) error {
+		validationErr := hvalid.NewValidationError(v.FieldName)
+
+		if err := parse(field); err != nil {
+			validationErr.AddError(message)
+			return validationErr
+		}
+		return nil
+	})
+}
+
+// MustEpochUnit 时间戳单位不是 time.Second、time.Millisecond、time.Microsecond 或 time.Nanosecond 时 panic
+func MustEpochUnit(unit time.Duration) {
+	switch unit {
+	case time.Second, time.Millisecond, time.Microsecond, time.Nanosecond:
+	default:
+		panic(fmt.Sprintf("primitive: invalid Unix timestamp unit %v", unit))
+	}
+}
+
+// epochUnitName 时间戳单位的名称
+func epochUnitName(unit time.Duration) string {
+	switch unit {
+	case time.Second:
+		return "seconds"
+	case time.Millisecond:
+		return "milliseconds"
+	case time.Microsecond:
+		return "microseconds"
+	default:
+		return "nanoseconds"
+	}
+}